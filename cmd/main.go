@@ -55,7 +55,11 @@ func main() {
 	}()
 	logger.Info("init tracer success")
 
-	authApp := app.NewSongApp(ctx, cfg, logger, postgresDatabase, tracer)
+	authApp, err := app.NewSongApp(ctx, cfg, logger, postgresDatabase, tracer)
+	if err != nil {
+		logger.Error("init app failed", slog.String("error", err.Error()))
+		return
+	}
 	logger.Info("init app success")
 
 	logger.Info("run app")
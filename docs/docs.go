@@ -15,9 +15,38 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/songs": {
+        "/admin/purge": {
+            "post": {
+                "description": "Окончательное удаление песен и групп, удалённых раньше срока хранения, групп без песен и просроченных записей кэша",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Purge deleted songs and groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PurgeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups": {
             "get": {
-                "description": "Получение списка песен с фильтрацией по всем полям и пагинацией",
+                "description": "Получение списка групп с фильтрацией по названию и пагинацией",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,52 +54,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "groups"
                 ],
-                "summary": "Get songs list",
+                "summary": "Get groups list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Name of song",
-                        "name": "song",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group name of song",
-                        "name": "group",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "\"2020-01-01\"",
-                        "description": "Start date for release date filter",
-                        "name": "release_date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "\"2023-01-01\"",
-                        "description": "End date for release date filter",
-                        "name": "release_date_to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Text content of the song",
-                        "name": "text",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "URL link for the song",
-                        "name": "link",
+                        "description": "Group name",
+                        "name": "name",
                         "in": "query"
                     },
                     {
+                        "maximum": 1000,
                         "type": "integer",
                         "default": 10,
-                        "description": "Limit of songs",
+                        "description": "Limit of groups",
                         "name": "limit",
                         "in": "query"
                     },
@@ -86,25 +84,25 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.SongListResponse"
+                            "$ref": "#/definitions/handlers.GroupListResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid query parameters",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Добавление песни в библиотеку из Music Service",
+                "description": "Добавление группы в библиотеку",
                 "consumes": [
                     "application/json"
                 ],
@@ -112,17 +110,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "groups"
                 ],
-                "summary": "Add song from Music Service",
+                "summary": "Create group",
                 "parameters": [
                     {
-                        "description": "Song details",
+                        "description": "Group details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateSongRequest"
+                            "$ref": "#/definitions/handlers.CreateGroupRequest"
                         }
                     }
                 ],
@@ -130,33 +128,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateSongResponse"
+                            "$ref": "#/definitions/handlers.CreateGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input data",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "409": {
-                        "description": "Song already exists",
+                        "description": "Group already exists",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
             }
         },
-        "/songs/{id}": {
+        "/groups/{id}": {
             "get": {
-                "description": "Получение песни с пагинацией по куплетам",
+                "description": "Получение группы по ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -164,59 +162,95 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "groups"
                 ],
-                "summary": "Get song",
+                "summary": "Get group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Song ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GroupResponse"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "Limit number of verses",
-                        "name": "limit",
-                        "in": "query"
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление группы по ID. Песни группы скрываются вместе с ней",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Delete group by ID",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.SongResponse"
+                            "$ref": "#/definitions/handlers.DeleteGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid ID format",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Group not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Полное обновление информации о песне в библиотеке",
+            "patch": {
+                "description": "Переименование группы. Если группа с новым названием уже существует, песни переносятся в неё",
                 "consumes": [
                     "application/json"
                 ],
@@ -224,24 +258,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "groups"
                 ],
-                "summary": "Update song by ID",
+                "summary": "Rename group by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Song ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Song details to update",
+                        "description": "New group name",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateSongRequest"
+                            "$ref": "#/definitions/handlers.RenameGroupRequest"
                         }
                     }
                 ],
@@ -249,37 +283,39 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateSongResponse"
+                            "$ref": "#/definitions/handlers.RenameGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input data",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Group not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "409": {
-                        "description": "Name conflict",
+                        "description": "Song name conflict",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Удаление песни из библиотеки по ID",
+            }
+        },
+        "/groups/{id}/restore": {
+            "post": {
+                "description": "Восстановление удалённой группы вместе с её песнями",
                 "consumes": [
                     "application/json"
                 ],
@@ -287,13 +323,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "groups"
                 ],
-                "summary": "Delete song by ID",
+                "summary": "Restore deleted group by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Song ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -303,31 +339,59 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.DeleteSongResponse"
+                            "$ref": "#/definitions/handlers.RestoreGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid ID format",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Group not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Active group with the same name exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Частичное обновление информации о песне в библиотеке",
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Проверка состояния сервиса. Статус degraded означает, что один из поставщиков данных о песнях недоступен и его circuit breaker не закрыт. Для каждого поставщика также возвращается число попаданий и промахов кэша",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{id}": {
+            "get": {
+                "description": "Получение статуса задачи добавления песни: pending, running, succeeded или dead. Для выполненной задачи возвращается ID песни, для неудачной — последняя ошибка",
                 "consumes": [
                     "application/json"
                 ],
@@ -335,115 +399,2311 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "jobs"
                 ],
-                "summary": "Partially update song by ID",
+                "summary": "Get song job",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Song ID",
+                        "description": "Job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Song details to be updated",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.PartialUpdateSongRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.PartialUpdateSongResponse"
+                            "$ref": "#/definitions/handlers.SongJobResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid input data",
+                        "description": "Invalid ID format",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "409": {
-                        "description": "Name conflict",
+                        "description": "Job not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handlers.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.CreateSongRequest": {
-            "type": "object",
-            "required": [
-                "group",
-                "song"
-            ],
-            "properties": {
-                "group": {
-                    "type": "string"
-                },
-                "song": {
-                    "type": "string"
-                }
-            }
-        },
-        "handlers.CreateSongResponse": {
-            "type": "object",
-            "properties": {
-                "song": {
-                    "$ref": "#/definitions/models.Song"
-                }
-            }
-        },
-        "handlers.DeleteSongResponse": {
-            "type": "object",
-            "properties": {
-                "deleted_time": {
-                    "type": "string"
-                }
-            }
-        },
-        "handlers.PartialUpdateSongRequest": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "type": "string"
-                },
-                "link": {
-                    "type": "string"
-                },
-                "release_date": {
-                    "type": "string"
-                },
-                "song": {
-                    "type": "string"
-                },
-                "text": {
-                    "type": "string"
-                }
-            }
         },
-        "handlers.PartialUpdateSongResponse": {
+        "/songs": {
+            "get": {
+                "description": "Получение списка песен с фильтрацией по всем полям, полнотекстовым поиском и пагинацией",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get songs list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Full-text search by song, group and text (websearch syntax)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of song",
+                        "name": "song",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for song filter",
+                        "name": "song_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group name of song",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for group filter",
+                        "name": "group_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2020-01-01\"",
+                        "description": "Start date for release date filter",
+                        "name": "release_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2023-01-01\"",
+                        "description": "End date for release date filter",
+                        "name": "release_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text content of the song",
+                        "name": "text",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL link for the song",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for link filter",
+                        "name": "link_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language of a translation the song has",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "-release_date,group,song",
+                        "description": "Comma separated sort keys: song, group, release_date, relevance, similarity. Prefix with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit of songs",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from next_cursor or prev_cursor of a previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Count all songs matching the filter",
+                        "name": "include_total",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongListResponse"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links to the first, previous, next and last pages"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Number of songs matching the filter, only with include_total"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Добавление песни в библиотеку. Если дата выпуска, текст и ссылка не указаны, они загружаются из поставщиков данных о песнях, если указаны все, песня добавляется как есть. С enrich=missing из поставщиков загружаются только неуказанные поля, с enrich=none поставщики не опрашиваются. С параметром async песня добавляется в фоне, а в ответ возвращается задача, статус которой можно получить через /jobs/{id}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Add song",
+                "parameters": [
+                    {
+                        "description": "Song details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSongRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Add song in background, only without song details",
+                        "name": "async",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "missing",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "Fetch only missing song details or none of them",
+                        "name": "enrich",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSongResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSongJobResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "Job URL"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found by song info providers",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Song already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid song fields",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "502": {
+                        "description": "Song info providers request failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Song info providers are unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/batch": {
+            "post": {
+                "description": "Пакетное добавление до 100 песен, недостающие детали песен загружаются из поставщиков данных о песнях. Для каждой песни возвращается статус: created, duplicate, not_found, invalid, failed или skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Add songs in batch",
+                "parameters": [
+                    {
+                        "description": "Songs to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSongBatchRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Create all songs or none of them",
+                        "name": "atomic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSongBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/refresh": {
+            "post": {
+                "description": "Повторная загрузка данных песен, подходящих под фильтр, из поставщиков данных о песнях. Для каждой песни возвращается статус: updated, outdated (только в режиме dry_run), unchanged, not_found или failed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Refresh songs by filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Full-text search by song, group and text (websearch syntax)",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of song",
+                        "name": "song",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for song filter",
+                        "name": "song_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group name of song",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for group filter",
+                        "name": "group_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2020-01-01\"",
+                        "description": "Start date for release date filter",
+                        "name": "release_date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2023-01-01\"",
+                        "description": "End date for release date filter",
+                        "name": "release_date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text content of the song",
+                        "name": "text",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL link for the song",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "exact",
+                            "insensitive",
+                            "prefix",
+                            "contains",
+                            "fuzzy"
+                        ],
+                        "type": "string",
+                        "default": "exact",
+                        "description": "Match mode for link filter",
+                        "name": "link_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language of a translation the song has",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of songs to refresh",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Return the changes without saving them",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/trash": {
+            "get": {
+                "description": "Получение списка удалённых песен, начиная с последних удалённых",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get deleted songs list",
+                "parameters": [
+                    {
+                        "maximum": 1000,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit of songs",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeletedSongListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}": {
+            "get": {
+                "description": "Получение песни с пагинацией по куплетам. В ответе возвращаются общее число куплетов, смещение и размер страницы, а также признак наличия следующих куплетов. С параметром verses в ответе также возвращаются куплеты страницы с типом (куплет, припев, бридж и т.д.) и строками, для повторов указывается номер повторяемого куплета. С параметром collapse_repeats повторяющиеся куплеты (в том числе почти совпадающие) заменяются меткой повтора, так что каждая часть песни возвращается один раз. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращается оригинальный текст. Смещение за последним куплетом возвращает 416",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of verses",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Return parsed verses of the page",
+                        "name": "verses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Replace repeated verses with repeat markers",
+                        "name": "collapse_repeats",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language of the translation to return",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of the text, used without lang",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached song version",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongResponse"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language of the translation, if one is returned"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version and language of the text"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Song version matches If-None-Match",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, pagination or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "416": {
+                        "description": "Offset is past the last verse",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Полная замена информации о песне в библиотеке. Все поля, кроме языка текста, обязательны, текст и ссылка не могут быть пустыми (очистить их можно через PATCH), неизвестные поля отклоняются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Update song by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Song details to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSongRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSongResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Name conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid song fields",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление песни из библиотеки по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Delete song by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Частичное обновление информации о песне в библиотеке. Тело запроса с типом application/merge-patch+json (или application/json) применяется как JSON Merge Patch (RFC 7396): поля со значением null очищаются. С типом application/json-patch+json тело является списком операций JSON Patch (RFC 6902). Изменения применяются к сохранённой песне в одной транзакции",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Partially update song by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch or JSON patch of the song",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PartialUpdateSongRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PartialUpdateSongResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid patch document",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Name conflict or patch can't be applied",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid song fields",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/lyrics/synced": {
+            "get": {
+                "description": "Получение синхронизированного текста песни с временными метками строк. Формат ответа выбирается по заголовку Accept: JSON (по умолчанию), LRC (text/x-lrc или text/plain) или WebVTT (text/vtt)",
+                "produces": [
+                    "application/json",
+                    "text/x-lrc",
+                    "text/vtt",
+                    "text/plain"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get synced lyrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SyncedLyricsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or synced lyrics not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "406": {
+                        "description": "Requested format is not supported",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Загрузка синхронизированного текста песни в формате LRC или расширенного LRC с временными метками слов. Все строки проверяются, ошибки возвращаются с номерами строк. Текст песни заменяется текстом синхронизированных строк, при последующем изменении текста песни синхронизированный текст удаляется",
+                "consumes": [
+                    "text/x-lrc",
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Upload synced lyrics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lyrics in LRC or enhanced LRC",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSyncedLyricsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported lyrics format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid lyrics lines",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/refresh": {
+            "post": {
+                "description": "Повторная загрузка данных песни из поставщиков данных о песнях. Изменённые поля сохраняются как новая ревизия, в режиме dry_run изменения только возвращаются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Refresh song",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Return the changes without saving them",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Song changed during refresh",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "502": {
+                        "description": "Song info providers failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Song info providers unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/restore": {
+            "post": {
+                "description": "Восстановление удалённой песни по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Restore deleted song by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RestoreSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Active song with the same name and group exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/revisions": {
+            "get": {
+                "description": "Получение истории изменений песни",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongRevisionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/revisions/{rev}": {
+            "get": {
+                "description": "Получение состояния песни в указанной ревизии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongRevisionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or revision format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/revisions/{rev}/diff/{other}": {
+            "get": {
+                "description": "Сравнение двух ревизий песни по полям и по куплетам текста",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Compare song revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to compare from",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to compare to",
+                        "name": "other",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongRevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or revision format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/revisions/{rev}/revert": {
+            "post": {
+                "description": "Восстановление состояния песни из указанной ревизии, сохраняется как новая ревизия. Восстановленные поля проверяются так же, как при частичном обновлении",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Revert song to revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "rev",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to revert",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or revision format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or revision not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Name conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Reverted song fields are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/translations": {
+            "get": {
+                "description": "Получение всех переводов текста песни, упорядоченных по языку",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongTranslationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/translations/{lang}": {
+            "get": {
+                "description": "Получение перевода текста песни на язык, заданный тегом BCP 47",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language tag",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongTranslationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Добавление или замена перевода текста песни на язык, заданный тегом BCP 47. Тег приводится к каноническому виду, например pt_br сохраняется как pt-BR. Изменение перевода меняет версию песни",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Add or update song translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language tag",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated text",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSongTranslationRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSongTranslationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid translation fields",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление перевода текста песни, в ответе возвращается удаленный перевод. Удаление перевода меняет версию песни",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Delete song translation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language tag",
+                        "name": "lang",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the song version to update",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DeleteSongTranslationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Song version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or translation not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Song version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/verses": {
+            "get": {
+                "description": "Получение куплетов песни с пагинацией. Каждый куплет возвращается с типом (куплет, припев, бридж и т.д.) и строками, в ответе также возвращаются общее число куплетов, смещение и размер страницы и признак наличия следующих куплетов. Для повторов указывается номер повторяемого куплета, с параметром collapse_repeats их строки заменяются меткой повтора. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращаются куплеты оригинального текста. Смещение за последним куплетом возвращает 416",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song verses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of verses",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Replace repeated verses with repeat markers",
+                        "name": "collapse_repeats",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language of the translation to return",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of the text, used without lang",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongVersesResponse"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language of the translation, if one is returned"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, pagination or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "416": {
+                        "description": "Offset is past the last verse",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/verses/{n}": {
+            "get": {
+                "description": "Получение куплета песни по его номеру, номера куплетов начинаются с нуля. В ответе также возвращается общее число куплетов. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращается куплет оригинального текста",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song verse",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Verse number, starting from 0",
+                        "name": "n",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "de",
+                        "description": "Language of the translation to return",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Preferred languages of the text, used without lang",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SongVerseResponse"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Language of the translation, if one is returned"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, verse number format or language",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or verse not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "client.CacheStats": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.CreateGroupRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "handlers.CreateGroupResponse": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/models.Group"
+                }
+            }
+        },
+        "handlers.CreateSongBatchItem": {
+            "type": "object",
+            "required": [
+                "group",
+                "song"
+            ],
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateSongBatchRequest": {
+            "type": "object",
+            "required": [
+                "songs"
+            ],
+            "properties": {
+                "songs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handlers.CreateSongBatchItem"
+                    }
+                }
+            }
+        },
+        "handlers.CreateSongBatchResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.CreateSongBatchResult"
+                    }
+                }
+            }
+        },
+        "handlers.CreateSongBatchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "upstream_unavailable"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validation.FieldError"
+                    }
+                }
+            }
+        },
+        "handlers.CreateSongJobResponse": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/models.SongJob"
+                }
+            }
+        },
+        "handlers.CreateSongRequest": {
+            "type": "object",
+            "required": [
+                "group",
+                "song"
+            ],
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateSongResponse": {
+            "type": "object",
+            "properties": {
+                "song": {
+                    "$ref": "#/definitions/models.Song"
+                }
+            }
+        },
+        "handlers.DeleteGroupResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteSongResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.DeleteSongTranslationResponse": {
+            "type": "object",
+            "properties": {
+                "translation": {
+                    "$ref": "#/definitions/models.SongTranslation"
+                }
+            }
+        },
+        "handlers.DeletedSongListResponse": {
+            "type": "object",
+            "properties": {
+                "song_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeletedSong"
+                    }
+                }
+            }
+        },
+        "handlers.GroupListResponse": {
+            "type": "object",
+            "properties": {
+                "group_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Group"
+                    }
+                }
+            }
+        },
+        "handlers.GroupResponse": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/models.Group"
+                }
+            }
+        },
+        "handlers.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ProviderHealth"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ok",
+                        "degraded"
+                    ]
+                }
+            }
+        },
+        "handlers.PartialUpdateSongRequest": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PartialUpdateSongResponse": {
+            "type": "object",
+            "properties": {
+                "song": {
+                    "$ref": "#/definitions/models.Song"
+                }
+            }
+        },
+        "handlers.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "not_found"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "Song not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validation.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/songs/0b4a1f4e-4f7c-4b1a-9a8e-0a4b6c3e2d1f"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "trace_id": {
+                    "type": "string",
+                    "example": "4bf92f3577b34da6a3ce929d0e0e4736"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:song-service:problem:not_found"
+                }
+            }
+        },
+        "handlers.ProviderHealth": {
+            "type": "object",
+            "properties": {
+                "cache": {
+                    "$ref": "#/definitions/client.CacheStats"
+                },
+                "circuit_breaker": {
+                    "type": "string",
+                    "enum": [
+                        "closed",
+                        "open",
+                        "half-open"
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PurgeResponse": {
+            "type": "object",
+            "properties": {
+                "purged": {
+                    "$ref": "#/definitions/models.PurgeResult"
+                }
+            }
+        },
+        "handlers.RefreshSongResponse": {
+            "type": "object",
+            "properties": {
+                "refresh": {
+                    "$ref": "#/definitions/models.SongRefresh"
+                }
+            }
+        },
+        "handlers.RefreshSongsResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.RefreshSongsResult"
+                    }
+                }
+            }
+        },
+        "handlers.RefreshSongsResult": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldChange"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "upstream_unavailable"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RenameGroupRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "handlers.RenameGroupResponse": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/models.Group"
+                }
+            }
+        },
+        "handlers.RestoreGroupResponse": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/models.Group"
+                }
+            }
+        },
+        "handlers.RestoreSongResponse": {
             "type": "object",
             "properties": {
                 "song": {
@@ -451,22 +2711,163 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.SongJobResponse": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/models.SongJob"
+                }
+            }
+        },
         "handlers.SongListResponse": {
             "type": "object",
             "properties": {
-                "song_list": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "song_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongMatch"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.SongResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.Song"
+                },
+                "verse_limit": {
+                    "type": "integer"
+                },
+                "verse_offset": {
+                    "type": "integer"
+                },
+                "verse_total": {
+                    "type": "integer"
+                },
+                "verses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Verse"
+                    }
+                }
+            }
+        },
+        "handlers.SongRevisionDiffResponse": {
+            "type": "object",
+            "properties": {
+                "diff": {
+                    "$ref": "#/definitions/models.SongDiff"
+                }
+            }
+        },
+        "handlers.SongRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "revision": {
+                    "$ref": "#/definitions/models.SongRevision"
+                }
+            }
+        },
+        "handlers.SongRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "revision_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongRevision"
+                    }
+                }
+            }
+        },
+        "handlers.SongTranslationResponse": {
+            "type": "object",
+            "properties": {
+                "translation": {
+                    "$ref": "#/definitions/models.SongTranslation"
+                }
+            }
+        },
+        "handlers.SongTranslationsResponse": {
+            "type": "object",
+            "properties": {
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongTranslation"
+                    }
+                }
+            }
+        },
+        "handlers.SongVerseResponse": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string"
+                },
+                "verse": {
+                    "$ref": "#/definitions/models.Verse"
+                },
+                "verse_total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.SongVersesResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "verse_limit": {
+                    "type": "integer"
+                },
+                "verse_offset": {
+                    "type": "integer"
+                },
+                "verse_total": {
+                    "type": "integer"
+                },
+                "verses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Song"
+                        "$ref": "#/definitions/models.Verse"
                     }
                 }
             }
         },
-        "handlers.SongResponse": {
+        "handlers.SyncedLyricsResponse": {
             "type": "object",
             "properties": {
-                "song": {
-                    "$ref": "#/definitions/models.Song"
+                "lyrics": {
+                    "$ref": "#/definitions/models.SyncedLyrics"
                 }
             }
         },
@@ -483,6 +2884,10 @@ const docTemplate = `{
                 "group": {
                     "type": "string"
                 },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
                 "link": {
                     "type": "string"
                 },
@@ -505,6 +2910,108 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.UpdateSongTranslationRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateSongTranslationResponse": {
+            "type": "object",
+            "properties": {
+                "translation": {
+                    "$ref": "#/definitions/models.SongTranslation"
+                }
+            }
+        },
+        "handlers.UpdateSyncedLyricsResponse": {
+            "type": "object",
+            "properties": {
+                "lyrics": {
+                    "$ref": "#/definitions/models.SyncedLyrics"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.Song"
+                }
+            }
+        },
+        "models.DeletedSong": {
+            "type": "object",
+            "properties": {
+                "deleted_time": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "description": "Language is the BCP 47 tag of the original text, empty if it is unknown.",
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Group": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "song_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PurgeResult": {
+            "type": "object",
+            "properties": {
+                "cache_entries": {
+                    "type": "integer"
+                },
+                "groups": {
+                    "type": "integer"
+                },
+                "songs": {
+                    "type": "integer"
+                }
+            }
+        },
         "models.Song": {
             "type": "object",
             "properties": {
@@ -514,17 +3021,267 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "language": {
+                    "description": "Language is the BCP 47 tag of the original text, empty if it is unknown.",
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongDiff": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldChange"
+                    }
+                },
+                "from": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "integer"
+                },
+                "verses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.VerseChange"
+                    }
+                }
+            }
+        },
+        "models.SongJob": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_time": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "run_time": {
+                    "type": "string"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "song_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongMatch": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "description": "Language is the BCP 47 tag of the original text, empty if it is unknown.",
+                    "type": "string"
+                },
                 "link": {
                     "type": "string"
                 },
+                "rank": {
+                    "type": "number"
+                },
                 "release_date": {
                     "type": "string"
                 },
+                "similarity": {
+                    "type": "number"
+                },
+                "song": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongRefresh": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldChange"
+                    }
+                },
+                "song": {
+                    "$ref": "#/definitions/models.Song"
+                }
+            }
+        },
+        "models.SongRevision": {
+            "type": "object",
+            "properties": {
+                "created_time": {
+                    "type": "string"
+                },
+                "operation": {
+                    "type": "string"
+                },
+                "revision": {
+                    "type": "integer"
+                },
                 "song": {
+                    "$ref": "#/definitions/models.Song"
+                }
+            }
+        },
+        "models.SongTranslation": {
+            "type": "object",
+            "properties": {
+                "language": {
                     "type": "string"
                 },
                 "text": {
                     "type": "string"
+                },
+                "updated_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SyncedLine": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                },
+                "time_ms": {
+                    "type": "integer"
+                },
+                "words": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SyncedWord"
+                    }
+                }
+            }
+        },
+        "models.SyncedLyrics": {
+            "type": "object",
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SyncedLine"
+                    }
+                },
+                "tags": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.SyncedWord": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                },
+                "time_ms": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Verse": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "repeat_of": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "verse",
+                        "chorus",
+                        "pre_chorus",
+                        "bridge",
+                        "intro",
+                        "outro"
+                    ]
+                }
+            }
+        },
+        "models.VerseChange": {
+            "type": "object",
+            "properties": {
+                "operation": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "validation.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         }
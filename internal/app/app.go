@@ -6,12 +6,15 @@ import (
 	"log/slog"
 	"net/http"
 	"song-service/internal/application/services"
+	"song-service/internal/application/workers"
 	"song-service/internal/infrastructure/database/postgres"
 	pgrepo "song-service/internal/infrastructure/repository"
 	"song-service/internal/pkg/config"
+	"song-service/internal/pkg/cursor"
+	"song-service/internal/pkg/ratelimit"
 	"song-service/internal/pkg/server"
-	"song-service/internal/presentation/client"
 	handlers "song-service/internal/presentation/handlers"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
@@ -23,32 +26,70 @@ const (
 )
 
 type Config struct {
-	Mode         string              `yaml:"mode"          env-required:"true"`
-	Server       config.Server       `yaml:"server"        env-required:"true"`
-	Logger       config.Logger       `yaml:"logging"       env-required:"true"`
-	Tracing      config.Tracing      `yaml:"tracing"       env-required:"true"`
-	MusicService config.MusicService `yaml:"music_service" env-required:"true"`
-	Postgres     config.Postgres
+	Mode      string           `yaml:"mode"          env-required:"true"`
+	Server    config.Server    `yaml:"server"        env-required:"true"`
+	Logger    config.Logger    `yaml:"logging"       env-required:"true"`
+	Tracing   config.Tracing   `yaml:"tracing"       env-required:"true"`
+	SongInfo  config.SongInfo  `yaml:"song_info"     env-required:"true"`
+	Retention config.Retention `yaml:"retention"     env-required:"true"`
+	SongJobs  config.SongJobs  `yaml:"jobs"          env-required:"true"`
+	Refresh   config.Refresh   `yaml:"refresh"       env-required:"true"`
+	Postgres  config.Postgres
+	Cursor    config.Cursor
+}
+
+func (c *Config) Validate() error {
+	return errors.Join(c.Retention.Validate(), c.Refresh.Validate())
 }
 
 type SongApp struct {
-	logger     *slog.Logger
-	httpServer *server.HTTPServer
+	logger        *slog.Logger
+	httpServer    *server.HTTPServer
+	purgeWorker   *workers.PurgeWorker
+	songJobWorker *workers.SongJobWorker
+	refreshWorker *workers.RefreshWorker
 }
 
-func NewSongApp(ctx context.Context, cfg *Config, logger *slog.Logger, postgresDatabase postgres.Database, tracer trace.Tracer) *SongApp {
+func NewSongApp(ctx context.Context, cfg *Config, logger *slog.Logger, postgresDatabase postgres.Database, tracer trace.Tracer) (*SongApp, error) {
+	var (
+		txManager       = postgres.NewTransactionManager(postgresDatabase.Pool)
+		cacheRepository = pgrepo.NewCacheRepository(txManager, logger, tracer)
+	)
+
+	songInfoCache, err := NewSongInfoCache(cfg.SongInfo.Cache, cacheRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	songInfoProvider, songInfoClients := NewSongInfoProvider(cfg.SongInfo, songInfoCache, logger)
+
+	cursorSigner := cursor.NewSigner(cfg.Cursor.Secret)
+
 	var (
-		txManager = postgres.NewTransactionManager(postgresDatabase.Pool)
+		songRepository    = pgrepo.NewSongRepository(txManager, logger, tracer)
+		songJobRepository = pgrepo.NewSongJobRepository(txManager, logger, tracer)
+		songService       = services.NewSongService(songRepository, txManager, tracer)
+		songJobService    = services.NewSongJobService(songJobRepository, songRepository, txManager, tracer, cfg.SongJobs.MaxAttempts, time.Duration(cfg.SongJobs.Lease), time.Duration(cfg.SongJobs.Backoff), time.Duration(cfg.SongJobs.MaxBackoff))
+		songJobWorker     = workers.NewSongJobWorker(songJobService, songInfoProvider, cfg.SongJobs.Workers, time.Duration(cfg.SongJobs.PollInterval), logger)
+		refreshService    = services.NewSongRefreshService(songRepository, txManager, songInfoProvider, ratelimit.NewLimiter(cfg.Refresh.RateLimit), tracer, time.Duration(cfg.Refresh.MaxAge), cfg.Refresh.BatchSize)
+		songHandler       = handlers.NewSongHandler(songService, songJobService, refreshService, logger, tracer, songInfoProvider, cursorSigner)
+		jobHandler        = handlers.NewJobHandler(songJobService, logger, tracer)
 	)
 
-	musicServiceClient := client.NewMusicServiceClient(&http.Client{
-		Timeout: cfg.MusicService.Timeout,
-	}, cfg.MusicService.Address)
+	var (
+		groupRepository = pgrepo.NewGroupRepository(txManager, logger, tracer)
+		groupService    = services.NewGroupService(groupRepository, txManager, tracer)
+		groupHandler    = handlers.NewGroupHandler(groupService, logger, tracer)
+	)
+
+	var (
+		purgeService = services.NewPurgeService(songRepository, groupRepository, cacheRepository, txManager, tracer, time.Duration(cfg.Retention.DeletedSongs), cfg.Retention.BatchSize)
+		purgeWorker  = workers.NewPurgeWorker(purgeService, time.Duration(cfg.Retention.PurgeInterval), logger)
+		adminHandler = handlers.NewAdminHandler(purgeService, logger, tracer)
+	)
 
 	var (
-		songRepository = pgrepo.NewSongRepository(txManager, logger, tracer)
-		songService    = services.NewSongService(songRepository, tracer)
-		songHandler    = handlers.NewSongHandler(songService, logger, tracer, musicServiceClient)
+		healthHandler = handlers.NewHealthHandler(songInfoClients, logger, tracer)
 	)
 
 	gin.SetMode(cfg.Mode)
@@ -58,21 +99,31 @@ func NewSongApp(ctx context.Context, cfg *Config, logger *slog.Logger, postgresD
 	)
 
 	router.Use(
-		gin.Recovery(),
+		gin.CustomRecovery(handlers.Recovery),
 		otelgin.Middleware(ServiceName),
 		LogMiddleware(logger),
 	)
 
-	InitRoutes(router, songHandler)
+	router.NoRoute(handlers.NoRoute)
+
+	InitRoutes(router, songHandler, groupHandler, jobHandler, adminHandler, healthHandler)
 
 	var (
 		httpServer = server.NewHTTPServer(ctx, cfg.Server.Address, router)
 	)
 
-	return &SongApp{
-		logger:     logger,
-		httpServer: httpServer,
+	app := &SongApp{
+		logger:        logger,
+		httpServer:    httpServer,
+		purgeWorker:   purgeWorker,
+		songJobWorker: songJobWorker,
+	}
+
+	if cfg.Refresh.Scheduled {
+		app.refreshWorker = workers.NewRefreshWorker(refreshService, time.Duration(cfg.Refresh.Interval), logger)
 	}
+
+	return app, nil
 }
 
 func (a *SongApp) Run(ctx context.Context) error {
@@ -85,10 +136,41 @@ func (a *SongApp) Run(ctx context.Context) error {
 		}
 	}()
 
+	purgeDone := make(chan struct{})
+
+	go func() {
+		defer close(purgeDone)
+
+		a.purgeWorker.Run(ctx)
+	}()
+
+	songJobDone := make(chan struct{})
+
+	go func() {
+		defer close(songJobDone)
+
+		a.songJobWorker.Run(ctx)
+	}()
+
+	refreshDone := make(chan struct{})
+
+	go func() {
+		defer close(refreshDone)
+
+		if a.refreshWorker != nil {
+			a.refreshWorker.Run(ctx)
+		}
+	}()
+
 	go func() {
 		<-ctx.Done()
 
-		errChan <- a.httpServer.Shutdown()
+		err := a.httpServer.Shutdown()
+		<-purgeDone
+		<-songJobDone
+		<-refreshDone
+
+		errChan <- err
 	}()
 
 	return <-errChan
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"fmt"
+	pgrepo "song-service/internal/infrastructure/repository"
+	"song-service/internal/pkg/cache"
+	"song-service/internal/pkg/config"
+	"song-service/internal/presentation/client"
+)
+
+// NewSongInfoCache returns the configured cache backend, or nil if caching is disabled.
+func NewSongInfoCache(cfg config.Cache, cacheRepository *pgrepo.CacheRepository) (client.Cache, error) {
+	switch cfg.Backend {
+	case "memory":
+		return cache.NewLRU(cfg.Size), nil
+	case "postgres":
+		return cacheRepository, nil
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid cache backend parameter: %s", cfg.Backend)
+	}
+}
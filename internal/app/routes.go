@@ -9,13 +9,43 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func InitRoutes(router gin.IRoutes, songHandler *handlers.SongHandler) {
+func InitRoutes(router gin.IRoutes, songHandler *handlers.SongHandler, groupHandler *handlers.GroupHandler, jobHandler *handlers.JobHandler, adminHandler *handlers.AdminHandler, healthHandler *handlers.HealthHandler) {
 	router.POST("/songs", songHandler.CreateSong)
+	router.POST("/songs/batch", songHandler.CreateSongBatch)
+	router.POST("/songs/refresh", songHandler.RefreshSongs)
 	router.GET("/songs", songHandler.SongList)
+	router.GET("/songs/trash", songHandler.DeletedSongList)
 	router.GET("/songs/:id", songHandler.Song)
 	router.PUT("/songs/:id", songHandler.UpdateSong)
 	router.PATCH("/songs/:id", songHandler.PartialUpdateSong)
 	router.DELETE("/songs/:id", songHandler.DeleteSong)
+	router.POST("/songs/:id/restore", songHandler.RestoreSong)
+	router.POST("/songs/:id/refresh", songHandler.RefreshSong)
+	router.GET("/songs/:id/verses", songHandler.SongVerses)
+	router.GET("/songs/:id/verses/:n", songHandler.SongVerse)
+	router.GET("/songs/:id/lyrics/synced", songHandler.SyncedLyrics)
+	router.PUT("/songs/:id/lyrics/synced", songHandler.UpdateSyncedLyrics)
+	router.GET("/songs/:id/translations", songHandler.SongTranslations)
+	router.GET("/songs/:id/translations/:lang", songHandler.SongTranslation)
+	router.PUT("/songs/:id/translations/:lang", songHandler.UpdateSongTranslation)
+	router.DELETE("/songs/:id/translations/:lang", songHandler.DeleteSongTranslation)
+	router.GET("/songs/:id/revisions", songHandler.SongRevisions)
+	router.GET("/songs/:id/revisions/:rev", songHandler.SongRevision)
+	router.GET("/songs/:id/revisions/:rev/diff/:other", songHandler.SongRevisionDiff)
+	router.POST("/songs/:id/revisions/:rev/revert", songHandler.RevertSong)
+
+	router.POST("/groups", groupHandler.CreateGroup)
+	router.GET("/groups", groupHandler.GroupList)
+	router.GET("/groups/:id", groupHandler.Group)
+	router.PATCH("/groups/:id", groupHandler.RenameGroup)
+	router.DELETE("/groups/:id", groupHandler.DeleteGroup)
+	router.POST("/groups/:id/restore", groupHandler.RestoreGroup)
+
+	router.GET("/jobs/:id", jobHandler.SongJob)
+
+	router.POST("/admin/purge", adminHandler.Purge)
+
+	router.GET("/health", healthHandler.Health)
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
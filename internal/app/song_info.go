@@ -0,0 +1,47 @@
+package app
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/application/providers"
+	"song-service/internal/pkg/breaker"
+	"song-service/internal/pkg/config"
+	"song-service/internal/presentation/client"
+)
+
+// NewSongInfoProvider creates a client for every configured provider, each with its own
+// circuit breaker, and chains them. The clients are returned for health reporting.
+func NewSongInfoProvider(cfg config.SongInfo, cache client.Cache, logger *slog.Logger) (*providers.ChainProvider, []*client.MusicServiceClient) {
+	retryPolicy := client.RetryPolicy{
+		Attempts:   cfg.Retry.Attempts,
+		Backoff:    cfg.Retry.Backoff,
+		MaxBackoff: cfg.Retry.MaxBackoff,
+	}
+
+	cachePolicy := client.CachePolicy{
+		TTL:         cfg.Cache.TTL,
+		NotFoundTTL: cfg.Cache.NotFoundTTL,
+	}
+
+	var (
+		clients          = make([]*client.MusicServiceClient, 0, len(cfg.Providers))
+		chainedProviders = make([]providers.PrioritizedProvider, 0, len(cfg.Providers))
+	)
+
+	for _, providerCfg := range cfg.Providers {
+		circuitBreaker := breaker.NewCircuitBreaker(providerCfg.Name, cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.OpenTimeout, logger)
+
+		providerClient := client.NewMusicServiceClient(providerCfg.Name, &http.Client{
+			Timeout: providerCfg.Timeout,
+		}, providerCfg.Address, retryPolicy, circuitBreaker, cache, cachePolicy, logger)
+
+		clients = append(clients, providerClient)
+		chainedProviders = append(chainedProviders, providers.PrioritizedProvider{
+			Name:     providerCfg.Name,
+			Priority: providerCfg.Priority,
+			Provider: providerClient,
+		})
+	}
+
+	return providers.NewChainProvider(chainedProviders, logger), clients
+}
@@ -0,0 +1,121 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"song-service/internal/domain/models"
+)
+
+// PrioritizedProvider is a provider of a chain. Providers with a lower priority value
+// are preferred.
+type PrioritizedProvider struct {
+	Name     string
+	Priority int
+	Provider SongInfoProvider
+}
+
+// ChainProvider queries its providers one at a time in priority order and merges their
+// results: the first non-empty text and link win. Every provider is asked, even once text
+// and link are set, so that the earliest release date of all of them is used.
+type ChainProvider struct {
+	providers []PrioritizedProvider
+	logger    *slog.Logger
+}
+
+func NewChainProvider(providers []PrioritizedProvider, logger *slog.Logger) *ChainProvider {
+	providers = slices.Clone(providers)
+
+	slices.SortStableFunc(providers, func(a PrioritizedProvider, b PrioritizedProvider) int {
+		return a.Priority - b.Priority
+	})
+
+	return &ChainProvider{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// Info returns the merged song info. If no provider succeeds, the error wraps
+// ErrSongNotFound when every provider reported the song as missing. Otherwise it joins
+// the errors of the other failed providers, leaving out permanent errors if there are
+// transient ones, so that the request is retried when any provider may still succeed.
+func (p *ChainProvider) Info(ctx context.Context, group string, song string) (models.SongInfo, error) {
+	if len(p.providers) == 0 {
+		return models.SongInfo{}, fmt.Errorf("%w: no providers configured", ErrUnavailable)
+	}
+
+	var (
+		merged        models.SongInfo
+		found         bool
+		notFoundErr   error
+		permanentErrs []error
+		transientErrs []error
+	)
+
+	for _, provider := range p.providers {
+		songInfo, err := provider.Provider.Info(ctx, group, song)
+		if err != nil {
+			err = fmt.Errorf("%s: %w", provider.Name, err)
+
+			if errors.Is(err, ErrSongNotFound) {
+				if notFoundErr == nil {
+					notFoundErr = err
+				}
+
+				continue
+			}
+
+			p.logger.Warn("song info provider failed", slog.String("provider", provider.Name), slog.String("error", err.Error()))
+
+			if Permanent(err) {
+				permanentErrs = append(permanentErrs, err)
+			} else {
+				transientErrs = append(transientErrs, err)
+			}
+
+			continue
+		}
+
+		merged = mergeSongInfo(merged, songInfo, found)
+		found = true
+	}
+
+	if found {
+		return merged, nil
+	}
+
+	if len(transientErrs) > 0 {
+		return models.SongInfo{}, errors.Join(transientErrs...)
+	}
+
+	if len(permanentErrs) > 0 {
+		return models.SongInfo{}, errors.Join(permanentErrs...)
+	}
+
+	return models.SongInfo{}, notFoundErr
+}
+
+// mergeSongInfo fills the empty fields of merged from a lower priority songInfo and
+// keeps the earliest release date.
+func mergeSongInfo(merged models.SongInfo, songInfo models.SongInfo, found bool) models.SongInfo {
+	if !found {
+		return songInfo
+	}
+
+	if merged.Text == "" {
+		merged.Text = songInfo.Text
+	}
+
+	if merged.Link == "" {
+		merged.Link = songInfo.Link
+	}
+
+	if merged.ReleaseDate.IsZero() || (!songInfo.ReleaseDate.IsZero() && songInfo.ReleaseDate.Before(merged.ReleaseDate)) {
+		merged.ReleaseDate = songInfo.ReleaseDate
+	}
+
+	return merged
+}
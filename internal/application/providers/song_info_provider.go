@@ -0,0 +1,34 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"song-service/internal/domain/models"
+)
+
+var (
+	ErrSongNotFound = errors.New("song not found")
+	ErrRejected     = errors.New("song info request rejected")
+	ErrUnavailable  = errors.New("song info provider unavailable")
+	ErrInvalid      = errors.New("invalid song info")
+)
+
+// SongInfoProvider fetches metadata of a song. Implementations return errors wrapping
+// ErrSongNotFound if the song is unknown, ErrRejected if the request will never succeed,
+// ErrInvalid if the provider returned malformed details and ErrUnavailable if the provider
+// is known to be down. Other errors are transient.
+type SongInfoProvider interface {
+	Info(ctx context.Context, group string, song string) (models.SongInfo, error)
+}
+
+// SongInfoProviderFunc adapts a function to SongInfoProvider.
+type SongInfoProviderFunc func(ctx context.Context, group string, song string) (models.SongInfo, error)
+
+func (f SongInfoProviderFunc) Info(ctx context.Context, group string, song string) (models.SongInfo, error) {
+	return f(ctx, group, song)
+}
+
+// Permanent reports whether the provider error will not go away if the request is repeated.
+func Permanent(err error) bool {
+	return errors.Is(err, ErrSongNotFound) || errors.Is(err, ErrRejected) || errors.Is(err, ErrInvalid)
+}
@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+type CacheRepository interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Purge(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"song-service/internal/domain/models"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// Cursor points to a boundary row of a keyset page: the values of its sort keys
+// and its ID, which is always the last tie-breaker.
+type Cursor struct {
+	Sort     []SortField `json:"sort"`
+	Values   []string    `json:"values"`
+	ID       uuid.UUID   `json:"id"`
+	Backward bool        `json:"backward,omitempty"`
+}
+
+// EffectiveSongSort returns the sort order applied to the song list: the requested
+// one, or ordering by relevance and similarity when they are available.
+func EffectiveSongSort(filter *SongFilter, sort []SortField) []SortField {
+	if len(sort) > 0 || filter == nil {
+		return sort
+	}
+
+	if filter.Query != "" {
+		sort = append(sort, SortField{Field: SongSortRelevance, Descending: true})
+	}
+
+	if filter.IsFuzzy() {
+		sort = append(sort, SortField{Field: SongSortSimilarity, Descending: true})
+	}
+
+	return sort
+}
+
+func NewSongCursor(song models.SongMatch, sort []SortField, backward bool) Cursor {
+	values := make([]string, 0, len(sort))
+
+	for _, field := range sort {
+		var value string
+
+		switch field.Field {
+		case SongSortName:
+			value = song.Name
+		case SongSortGroup:
+			value = song.Group
+		case SongSortReleaseDate:
+			value = song.ReleaseDate.String()
+		case SongSortRelevance:
+			value = formatScore(song.Rank)
+		case SongSortSimilarity:
+			value = formatScore(song.Similarity)
+		}
+
+		values = append(values, value)
+	}
+
+	return Cursor{
+		Sort:     sort,
+		Values:   values,
+		ID:       song.ID,
+		Backward: backward,
+	}
+}
+
+func formatScore(score *float32) string {
+	if score == nil {
+		return "0"
+	}
+
+	return strconv.FormatFloat(float64(*score), 'g', -1, 32)
+}
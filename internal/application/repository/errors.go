@@ -3,6 +3,7 @@ package repo
 import "errors"
 
 var (
-	ErrObjectNotFound = errors.New("object not found")
-	ErrDuplicate      = errors.New("object is duplicate")
+	ErrObjectNotFound  = errors.New("object not found")
+	ErrDuplicate       = errors.New("object is duplicate")
+	ErrVersionMismatch = errors.New("object version mismatch")
 )
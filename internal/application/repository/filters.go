@@ -3,15 +3,55 @@ package repo
 import "github.com/hardfinhq/go-date"
 
 type Pagination struct {
-	Limit  int32 `form:"limit"`
-	Offset int32 `form:"offset"`
+	Limit  int32       `form:"limit"  binding:"omitempty,min=1,max=1000"`
+	Offset int32       `form:"offset"`
+	Sort   []SortField `form:"-"`
+	Cursor *Cursor     `form:"-"`
 }
 
+type SortField struct {
+	Field      string `json:"field"`
+	Descending bool   `json:"descending,omitempty"`
+}
+
+const (
+	SongSortName        = "song"
+	SongSortGroup       = "group"
+	SongSortReleaseDate = "release_date"
+	SongSortRelevance   = "relevance"
+	SongSortSimilarity  = "similarity"
+)
+
+type MatchMode string
+
+const (
+	MatchModeExact       MatchMode = "exact"
+	MatchModeInsensitive MatchMode = "insensitive"
+	MatchModePrefix      MatchMode = "prefix"
+	MatchModeContains    MatchMode = "contains"
+	MatchModeFuzzy       MatchMode = "fuzzy"
+)
+
 type SongFilter struct {
+	Query           string     `form:"q"`
 	Name            []string   `form:"song"`
+	NameMode        MatchMode  `form:"song_mode"  binding:"omitempty,oneof=exact insensitive prefix contains fuzzy"`
 	Group           []string   `form:"group"`
+	GroupMode       MatchMode  `form:"group_mode" binding:"omitempty,oneof=exact insensitive prefix contains fuzzy"`
 	ReleaseDateFrom *date.Date `form:"release_date_from"`
 	ReleaseDateTo   *date.Date `form:"release_date_to"`
 	Text            []string   `form:"text"`
 	Link            []string   `form:"link"`
+	LinkMode        MatchMode  `form:"link_mode"  binding:"omitempty,oneof=exact insensitive prefix contains fuzzy"`
+	Language        []string   `form:"lang"`
+}
+
+func (f SongFilter) IsFuzzy() bool {
+	return (f.NameMode == MatchModeFuzzy && len(f.Name) > 0) ||
+		(f.GroupMode == MatchModeFuzzy && len(f.Group) > 0) ||
+		(f.LinkMode == MatchModeFuzzy && len(f.Link) > 0)
+}
+
+type GroupFilter struct {
+	Name []string `form:"name"`
 }
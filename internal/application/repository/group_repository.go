@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"song-service/internal/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GroupRepository interface {
+	Create(ctx context.Context, group models.Group) (models.Group, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.Group, error)
+	GetByName(ctx context.Context, name string) (models.Group, error)
+	Lock(ctx context.Context, id uuid.UUID, name string) ([]models.Group, error)
+	List(ctx context.Context, filter *GroupFilter, pagination *Pagination) ([]models.Group, error)
+	Update(ctx context.Context, group models.Group) (models.Group, error)
+	MoveSongs(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	Restore(ctx context.Context, id uuid.UUID) (models.Group, error)
+	Purge(ctx context.Context, retention time.Duration, groupIDs []uuid.UUID) (int64, error)
+}
@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+	"song-service/internal/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SongJobRepository interface {
+	Create(ctx context.Context, job models.SongJob) (models.SongJob, error)
+	GetByID(ctx context.Context, id uuid.UUID) (models.SongJob, error)
+	Claim(ctx context.Context, lease time.Duration, batchSize int32) ([]models.SongJob, error)
+	Complete(ctx context.Context, job models.SongJob, songID uuid.UUID) error
+	Retry(ctx context.Context, job models.SongJob, backoff time.Duration, reason string) error
+	Fail(ctx context.Context, job models.SongJob, reason string) error
+}
@@ -8,10 +8,35 @@ import (
 	"github.com/google/uuid"
 )
 
+// SongBatchResult is the outcome of creating a single song of a batch.
+type SongBatchResult struct {
+	Song models.Song
+	Err  error
+}
+
 type SongRepository interface {
 	Create(ctx context.Context, song models.Song) (models.Song, error)
+	CreateBatch(ctx context.Context, songList []models.Song) ([]SongBatchResult, error)
 	GetByID(ctx context.Context, id uuid.UUID) (models.Song, error)
-	List(ctx context.Context, filter *SongFilter, pagination *Pagination) ([]models.Song, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (models.Song, error)
+	List(ctx context.Context, filter *SongFilter, pagination *Pagination) ([]models.SongMatch, error)
+	Count(ctx context.Context, filter *SongFilter) (int64, error)
 	Update(ctx context.Context, song models.Song) (models.Song, error)
 	Delete(ctx context.Context, id uuid.UUID) (*time.Time, error)
+	ListDeleted(ctx context.Context, pagination *Pagination) ([]models.DeletedSong, error)
+	Restore(ctx context.Context, id uuid.UUID) (models.Song, error)
+	Purge(ctx context.Context, retention time.Duration, batchSize int32) ([]uuid.UUID, error)
+	ListRevisions(ctx context.Context, id uuid.UUID) ([]models.SongRevision, error)
+	GetRevision(ctx context.Context, id uuid.UUID, revision int32) (models.SongRevision, error)
+	Revert(ctx context.Context, song models.Song) (models.Song, error)
+	Refresh(ctx context.Context, song models.Song) (models.Song, error)
+	MarkRefreshed(ctx context.Context, id uuid.UUID) error
+	ListStale(ctx context.Context, maxAge time.Duration, batchSize int32) ([]models.Song, error)
+	GetSyncedLyrics(ctx context.Context, id uuid.UUID) (models.SyncedLyrics, error)
+	SaveSyncedLyrics(ctx context.Context, song models.Song, synced models.SyncedLyrics) (models.Song, error)
+	IncrementVersion(ctx context.Context, id uuid.UUID) (int32, error)
+	ListTranslations(ctx context.Context, id uuid.UUID) ([]models.SongTranslation, error)
+	GetTranslation(ctx context.Context, id uuid.UUID, language string) (models.SongTranslation, error)
+	SaveTranslation(ctx context.Context, id uuid.UUID, translation models.SongTranslation) (models.SongTranslation, error)
+	DeleteTranslation(ctx context.Context, id uuid.UUID, language string) (models.SongTranslation, error)
 }
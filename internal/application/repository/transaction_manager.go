@@ -4,4 +4,5 @@ import "context"
 
 type TransactionManager interface {
 	WithTransaction(ctx context.Context, f func(ctx context.Context) error) error
+	WithSnapshot(ctx context.Context, f func(ctx context.Context) error) error
 }
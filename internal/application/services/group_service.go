@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GroupService struct {
+	repository repo.GroupRepository
+	txManager  repo.TransactionManager
+	tracer     trace.Tracer
+}
+
+func NewGroupService(repository repo.GroupRepository, txManager repo.TransactionManager, tracer trace.Tracer) *GroupService {
+	return &GroupService{
+		repository: repository,
+		txManager:  txManager,
+		tracer:     tracer,
+	}
+}
+
+func (s *GroupService) CreateGroup(ctx context.Context, group models.Group) (models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.CreateGroup")
+	defer span.End()
+
+	createdGroup, err := s.repository.Create(ctx, group)
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	return createdGroup, nil
+}
+
+func (s *GroupService) Group(ctx context.Context, id uuid.UUID) (models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.Group")
+	defer span.End()
+
+	group, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	return group, nil
+}
+
+func (s *GroupService) GroupList(ctx context.Context, filter *repo.GroupFilter, pagination *repo.Pagination) ([]models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.GroupList")
+	defer span.End()
+
+	groupList, err := s.repository.List(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupList, nil
+}
+
+// RenameGroup renames the group in place. If another active group already has
+// the requested name, all songs are moved into it and the renamed group is deleted.
+// Both groups are locked, so that neither is deleted while the songs are moved.
+func (s *GroupService) RenameGroup(ctx context.Context, group models.Group) (models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.RenameGroup")
+	defer span.End()
+
+	var renamedGroup models.Group
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		groupList, err := s.repository.Lock(ctx, group.ID, group.Name)
+		if err != nil {
+			return err
+		}
+
+		var currentGroup, targetGroup *models.Group
+
+		for i := range groupList {
+			if groupList[i].ID == group.ID {
+				currentGroup = &groupList[i]
+			} else {
+				targetGroup = &groupList[i]
+			}
+		}
+
+		if currentGroup == nil {
+			return fmt.Errorf("%w: group with id = %s not found", repo.ErrObjectNotFound, group.ID)
+		}
+
+		if currentGroup.Name == group.Name {
+			renamedGroup = *currentGroup
+			return nil
+		}
+
+		if targetGroup == nil {
+			renamedGroup, err = s.repository.Update(ctx, group)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}
+
+		if err := s.repository.MoveSongs(ctx, currentGroup.ID, targetGroup.ID); err != nil {
+			return err
+		}
+
+		if _, err := s.repository.Delete(ctx, currentGroup.ID); err != nil {
+			return err
+		}
+
+		renamedGroup, err = s.repository.GetByID(ctx, targetGroup.ID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return models.Group{}, err
+	}
+
+	return renamedGroup, nil
+}
+
+func (s *GroupService) DeleteGroup(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.DeleteGroup")
+	defer span.End()
+
+	deletedTime, err := s.repository.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedTime, nil
+}
+
+func (s *GroupService) RestoreGroup(ctx context.Context, id uuid.UUID) (models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GroupService.RestoreGroup")
+	defer span.End()
+
+	restoredGroup, err := s.repository.Restore(ctx, id)
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	return restoredGroup, nil
+}
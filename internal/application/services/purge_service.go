@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type PurgeService struct {
+	songRepository  repo.SongRepository
+	groupRepository repo.GroupRepository
+	cacheRepository repo.CacheRepository
+	txManager       repo.TransactionManager
+	tracer          trace.Tracer
+	retention       time.Duration
+	batchSize       int32
+}
+
+func NewPurgeService(songRepository repo.SongRepository, groupRepository repo.GroupRepository, cacheRepository repo.CacheRepository, txManager repo.TransactionManager, tracer trace.Tracer, retention time.Duration, batchSize int32) *PurgeService {
+	return &PurgeService{
+		songRepository:  songRepository,
+		groupRepository: groupRepository,
+		cacheRepository: cacheRepository,
+		txManager:       txManager,
+		tracer:          tracer,
+		retention:       retention,
+		batchSize:       batchSize,
+	}
+}
+
+// Purge hard deletes songs and groups soft deleted longer than the retention period ago,
+// together with deleted groups left without songs, and then expired cache entries. Every batch is
+// deleted in its own transaction, so rows purged before an error or cancellation stay purged.
+func (s *PurgeService) Purge(ctx context.Context) (models.PurgeResult, error) {
+	ctx, span := s.tracer.Start(ctx, "PurgeService.Purge")
+	defer span.End()
+
+	var result models.PurgeResult
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		var (
+			groupIDs   []uuid.UUID
+			groupCount int64
+		)
+
+		if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			groupIDs, err = s.songRepository.Purge(ctx, s.retention, s.batchSize)
+			if err != nil {
+				return err
+			}
+
+			groupCount, err = s.groupRepository.Purge(ctx, s.retention, groupIDs)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			return result, err
+		}
+
+		result.Songs += int64(len(groupIDs))
+		result.Groups += groupCount
+
+		if len(groupIDs) < int(s.batchSize) {
+			break
+		}
+	}
+
+	cacheCount, err := s.cacheRepository.Purge(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	result.CacheEntries = cacheCount
+
+	return result, nil
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/lyrics"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SongJobService struct {
+	jobRepository  repo.SongJobRepository
+	songRepository repo.SongRepository
+	txManager      repo.TransactionManager
+	tracer         trace.Tracer
+	maxAttempts    int32
+	lease          time.Duration
+	backoff        time.Duration
+	maxBackoff     time.Duration
+}
+
+func NewSongJobService(jobRepository repo.SongJobRepository, songRepository repo.SongRepository, txManager repo.TransactionManager, tracer trace.Tracer, maxAttempts int32, lease time.Duration, backoff time.Duration, maxBackoff time.Duration) *SongJobService {
+	return &SongJobService{
+		jobRepository:  jobRepository,
+		songRepository: songRepository,
+		txManager:      txManager,
+		tracer:         tracer,
+		maxAttempts:    maxAttempts,
+		lease:          lease,
+		backoff:        backoff,
+		maxBackoff:     maxBackoff,
+	}
+}
+
+// EnqueueSong queues a job adding the song with details fetched from the song info providers.
+func (s *SongJobService) EnqueueSong(ctx context.Context, group string, song string) (models.SongJob, error) {
+	ctx, span := s.tracer.Start(ctx, "SongJobService.EnqueueSong")
+	defer span.End()
+
+	if err := validation.ValidateSongName(song, group); err != nil {
+		return models.SongJob{}, err
+	}
+
+	job := models.SongJob{
+		Group:       group,
+		Song:        song,
+		MaxAttempts: s.maxAttempts,
+	}
+
+	createdJob, err := s.jobRepository.Create(ctx, job)
+	if err != nil {
+		return models.SongJob{}, err
+	}
+
+	return createdJob, nil
+}
+
+func (s *SongJobService) SongJob(ctx context.Context, id uuid.UUID) (models.SongJob, error) {
+	ctx, span := s.tracer.Start(ctx, "SongJobService.SongJob")
+	defer span.End()
+
+	job, err := s.jobRepository.GetByID(ctx, id)
+	if err != nil {
+		return models.SongJob{}, err
+	}
+
+	return job, nil
+}
+
+// ClaimSongJobs leases up to batchSize due jobs to the caller. A job not completed,
+// retried or failed before its lease expires is handed out again while it has attempts
+// left, otherwise it is marked dead.
+func (s *SongJobService) ClaimSongJobs(ctx context.Context, batchSize int32) ([]models.SongJob, error) {
+	ctx, span := s.tracer.Start(ctx, "SongJobService.ClaimSongJobs")
+	defer span.End()
+
+	jobList, err := s.jobRepository.Claim(ctx, s.lease, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobList, nil
+}
+
+// CompleteSongJob creates the song and marks the job as succeeded in one transaction,
+// so the song is not created if the job lease has been lost. The song is validated first,
+// the details fetched from the song info providers may be invalid.
+func (s *SongJobService) CompleteSongJob(ctx context.Context, job models.SongJob, song models.Song) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongJobService.CompleteSongJob")
+	defer span.End()
+
+	var createdSong models.Song
+
+	song.Text = lyrics.Normalize(song.Text)
+
+	if err := validation.ValidateSong(song); err != nil {
+		return models.Song{}, err
+	}
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		createdSong, err = s.songRepository.Create(ctx, song)
+		if err != nil {
+			return err
+		}
+
+		return s.jobRepository.Complete(ctx, job, createdSong.ID)
+	})
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	return createdSong, nil
+}
+
+// FailSongJob schedules the job to run again with exponential backoff, or moves it
+// to the dead state if the failure is permanent or the job is out of attempts.
+func (s *SongJobService) FailSongJob(ctx context.Context, job models.SongJob, reason string, retryable bool) (models.SongJob, error) {
+	ctx, span := s.tracer.Start(ctx, "SongJobService.FailSongJob")
+	defer span.End()
+
+	job.Error = &reason
+
+	if !retryable || job.Attempts >= job.MaxAttempts {
+		if err := s.jobRepository.Fail(ctx, job, reason); err != nil {
+			return models.SongJob{}, err
+		}
+
+		job.Status = models.SongJobDead
+
+		return job, nil
+	}
+
+	backoff := s.jobBackoff(job.Attempts)
+
+	if err := s.jobRepository.Retry(ctx, job, backoff, reason); err != nil {
+		return models.SongJob{}, err
+	}
+
+	job.Status = models.SongJobPending
+	job.RunTime = time.Now().Add(backoff)
+
+	return job, nil
+}
+
+// jobBackoff doubles the base backoff with every attempt up to the maximum backoff.
+func (s *SongJobService) jobBackoff(attempts int32) time.Duration {
+	backoff := s.backoff
+
+	for i := int32(1); i < attempts && backoff < s.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, s.maxBackoff)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"song-service/internal/pkg/jsonpatch"
+	"strings"
+
+	"github.com/hardfinhq/go-date"
+)
+
+// songDocument is the JSON representation of a song that patches are applied to. Removed
+// members become empty fields.
+type songDocument struct {
+	Name        string  `json:"song"`
+	Group       string  `json:"group"`
+	ReleaseDate *string `json:"release_date"`
+	Text        string  `json:"text"`
+	Link        string  `json:"link"`
+	Language    string  `json:"language"`
+}
+
+func applySongPatch(song models.Song, patch jsonpatch.Patcher) (models.Song, error) {
+	releaseDate := song.ReleaseDate.String()
+
+	doc, err := json.Marshal(songDocument{
+		Name:        song.Name,
+		Group:       song.Group,
+		ReleaseDate: &releaseDate,
+		Text:        song.Text,
+		Link:        song.Link,
+		Language:    song.Language,
+	})
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	patchedDoc, err := patch.Apply(doc)
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	var patched songDocument
+
+	decoder := json.NewDecoder(bytes.NewReader(patchedDoc))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&patched); err != nil {
+		return models.Song{}, songDocumentError(err)
+	}
+
+	song.Name = patched.Name
+	song.Group = patched.Group
+	song.Text = patched.Text
+	song.Link = patched.Link
+	song.Language = patched.Language
+	song.ReleaseDate = date.Date{}
+
+	if patched.ReleaseDate != nil {
+		if song.ReleaseDate, err = date.FromString(*patched.ReleaseDate); err != nil {
+			return models.Song{}, fieldError(validation.FieldReleaseDate, validation.CodeInvalidType, "must be a date in YYYY-MM-DD format")
+		}
+	}
+
+	return song, nil
+}
+
+// songDocumentError reports a patched document that is not a song as a validation error.
+func songDocumentError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if typeErr.Field == "" {
+			return fieldError("", validation.CodeInvalidType, "song must be an object")
+		}
+
+		return fieldError(typeErr.Field, validation.CodeInvalidType, fmt.Sprintf("must be %s", typeErr.Type.Kind()))
+	}
+
+	// The decoder reports unknown members only by their message.
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return fieldError(strings.Trim(field, `"`), validation.CodeUnknownField, "is not a song field")
+	}
+
+	return err
+}
+
+func fieldError(field string, code string, message string) error {
+	return &validation.Error{
+		Fields: []validation.FieldError{{Field: field, Code: code, Message: message}},
+	}
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"song-service/internal/application/providers"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/lyrics"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"song-service/internal/pkg/ratelimit"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	songRefreshConcurrency = 8
+)
+
+var (
+	ErrSongInfo = errors.New("failed to fetch song info")
+)
+
+type SongRefreshService struct {
+	songRepository   repo.SongRepository
+	txManager        repo.TransactionManager
+	songInfoProvider providers.SongInfoProvider
+	limiter          *ratelimit.Limiter
+	tracer           trace.Tracer
+	maxAge           time.Duration
+	batchSize        int32
+}
+
+func NewSongRefreshService(songRepository repo.SongRepository, txManager repo.TransactionManager, songInfoProvider providers.SongInfoProvider, limiter *ratelimit.Limiter, tracer trace.Tracer, maxAge time.Duration, batchSize int32) *SongRefreshService {
+	return &SongRefreshService{
+		songRepository:   songRepository,
+		txManager:        txManager,
+		songInfoProvider: songInfoProvider,
+		limiter:          limiter,
+		tracer:           tracer,
+		maxAge:           maxAge,
+		batchSize:        batchSize,
+	}
+}
+
+// RefreshSong fetches the song details from the song info providers and saves the fields
+// that differ. If dryRun is set, the changes are only returned.
+func (s *SongRefreshService) RefreshSong(ctx context.Context, id uuid.UUID, dryRun bool) (models.SongRefresh, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRefreshService.RefreshSong")
+	defer span.End()
+
+	song, err := s.songRepository.GetByID(ctx, id)
+	if err != nil {
+		return models.SongRefresh{}, err
+	}
+
+	return s.refresh(ctx, song, dryRun)
+}
+
+// SongRefreshItem is the outcome of refreshing a single song of a bulk refresh.
+type SongRefreshItem struct {
+	Song    models.Song
+	Refresh models.SongRefresh
+	Err     error
+}
+
+// RefreshSongs refreshes up to limit songs matching the filter with bounded concurrency.
+// A song that fails to refresh does not stop the others.
+func (s *SongRefreshService) RefreshSongs(ctx context.Context, filter *repo.SongFilter, limit int32, dryRun bool) ([]SongRefreshItem, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRefreshService.RefreshSongs")
+	defer span.End()
+
+	pagination := repo.Pagination{
+		Limit: limit,
+		Sort:  repo.EffectiveSongSort(filter, nil),
+	}
+
+	songList, err := s.songRepository.List(ctx, filter, &pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SongRefreshItem, len(songList))
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(songRefreshConcurrency)
+
+	for i, song := range songList {
+		items[i].Song = song.Song
+
+		group.Go(func() error {
+			items[i].Refresh, items[i].Err = s.refresh(ctx, song.Song, dryRun)
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	return items, nil
+}
+
+// RefreshStale refreshes songs not refreshed for longer than the max age, least recently
+// refreshed first. Songs missing or rejected upstream or invalid after the refresh are counted
+// as failed and not retried before the max age passes again, any other failure stops the
+// refresh until the next run.
+func (s *SongRefreshService) RefreshStale(ctx context.Context) (models.SongRefreshResult, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRefreshService.RefreshStale")
+	defer span.End()
+
+	var result models.SongRefreshResult
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		songList, err := s.songRepository.ListStale(ctx, s.maxAge, s.batchSize)
+		if err != nil {
+			return result, err
+		}
+
+		for _, song := range songList {
+			refresh, err := s.refresh(ctx, song, false)
+			if err != nil {
+				var validationErr *validation.Error
+
+				if !providers.Permanent(err) && !errors.As(err, &validationErr) {
+					return result, err
+				}
+
+				result.Failed++
+				continue
+			}
+
+			result.Refreshed++
+
+			if refresh.Applied {
+				result.Updated++
+			}
+		}
+
+		if len(songList) < int(s.batchSize) {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// refresh compares the song with the details fetched from the song info providers, fields
+// the providers left empty are kept. Unless dryRun is set, the changes are saved and the
+// song is marked as refreshed, which also happens if the song is missing or rejected upstream
+// or the refreshed song is invalid.
+func (s *SongRefreshService) refresh(ctx context.Context, song models.Song, dryRun bool) (models.SongRefresh, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return models.SongRefresh{}, err
+	}
+
+	songInfo, err := s.songInfoProvider.Info(ctx, song.Group, song.Name)
+	if err != nil {
+		if !dryRun && providers.Permanent(err) {
+			if err := s.songRepository.MarkRefreshed(ctx, song.ID); err != nil {
+				return models.SongRefresh{}, err
+			}
+		}
+
+		return models.SongRefresh{}, fmt.Errorf("%w: %w", ErrSongInfo, err)
+	}
+
+	if dryRun {
+		refresh := models.SongRefresh{
+			Song:    song,
+			Changes: diffSongFields(song, mergeSongInfo(song, songInfo)),
+		}
+
+		return refresh, nil
+	}
+
+	var refresh models.SongRefresh
+
+	err = s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// The song is read again under lock, it may have been changed while the song info
+		// was fetched, and listed songs don't carry their version.
+		currentSong, err := s.songRepository.GetByIDForUpdate(ctx, song.ID)
+		if err != nil {
+			return err
+		}
+
+		refreshedSong := mergeSongInfo(currentSong, songInfo)
+
+		refresh = models.SongRefresh{
+			Song:    currentSong,
+			Changes: diffSongFields(currentSong, refreshedSong),
+		}
+
+		if len(refresh.Changes) == 0 {
+			return s.songRepository.MarkRefreshed(ctx, song.ID)
+		}
+
+		if err := validation.ValidateSongPatch(refreshedSong); err != nil {
+			return err
+		}
+
+		if refresh.Song, err = s.songRepository.Refresh(ctx, refreshedSong); err != nil {
+			return err
+		}
+
+		refresh.Applied = true
+
+		return nil
+	})
+	if err != nil {
+		var validationErr *validation.Error
+
+		if errors.As(err, &validationErr) {
+			if err := s.songRepository.MarkRefreshed(ctx, song.ID); err != nil {
+				return models.SongRefresh{}, err
+			}
+		}
+
+		return models.SongRefresh{}, err
+	}
+
+	return refresh, nil
+}
+
+// mergeSongInfo returns the song with the details fetched from the song info providers,
+// fields the providers left empty are kept.
+func mergeSongInfo(song models.Song, songInfo models.SongInfo) models.Song {
+	song.ReleaseDate = cmp.Or(songInfo.ReleaseDate, song.ReleaseDate)
+	song.Text = lyrics.Normalize(cmp.Or(songInfo.Text, song.Text))
+	song.Link = cmp.Or(songInfo.Link, song.Link)
+
+	return song
+}
@@ -2,23 +2,35 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/lyrics"
 	"song-service/internal/domain/models"
-	"strings"
+	"song-service/internal/domain/validation"
+	"song-service/internal/pkg/diff"
+	"song-service/internal/pkg/jsonpatch"
 	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	ErrVerseOutOfRange = errors.New("verse offset out of range")
+)
+
 type SongService struct {
 	repository repo.SongRepository
+	txManager  repo.TransactionManager
 	tracer     trace.Tracer
 }
 
-func NewSongService(repository repo.SongRepository, tracer trace.Tracer) *SongService {
+func NewSongService(repository repo.SongRepository, txManager repo.TransactionManager, tracer trace.Tracer) *SongService {
 	return &SongService{
 		repository: repository,
+		txManager:  txManager,
 		tracer:     tracer,
 	}
 }
@@ -27,6 +39,12 @@ func (s *SongService) CreateSong(ctx context.Context, song models.Song) (models.
 	ctx, span := s.tracer.Start(ctx, "SongService.CreateSong")
 	defer span.End()
 
+	song = normalizeSong(song)
+
+	if err := validation.ValidateSong(song); err != nil {
+		return models.Song{}, err
+	}
+
 	createdSong, err := s.repository.Create(ctx, song)
 	if err != nil {
 		return models.Song{}, err
@@ -35,10 +53,80 @@ func (s *SongService) CreateSong(ctx context.Context, song models.Song) (models.
 	return createdSong, nil
 }
 
+type SongBatchResult struct {
+	Results   []repo.SongBatchResult
+	Committed bool
+}
+
+var errBatchRollback = errors.New("batch rollback")
+
+// CreateSongBatch validates the songs and creates the valid ones in one transaction. If atomic
+// is set and any song is invalid or fails, nothing is created.
+func (s *SongService) CreateSongBatch(ctx context.Context, songList []models.Song, atomic bool) (SongBatchResult, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.CreateSongBatch")
+	defer span.End()
+
+	var (
+		results   = make([]repo.SongBatchResult, len(songList))
+		valid     = make([]int, 0, len(songList))
+		validList = make([]models.Song, 0, len(songList))
+	)
+
+	for i, song := range songList {
+		song = normalizeSong(song)
+		results[i].Song = song
+
+		if err := validation.ValidateSong(song); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		valid = append(valid, i)
+		validList = append(validList, song)
+	}
+
+	if len(validList) == 0 || (atomic && len(validList) < len(songList)) {
+		return SongBatchResult{
+			Results: results,
+		}, nil
+	}
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		validResults, err := s.repository.CreateBatch(ctx, validList)
+		if err != nil {
+			return err
+		}
+
+		for j, i := range valid {
+			results[i] = validResults[j]
+		}
+
+		if atomic && slices.ContainsFunc(validResults, func(result repo.SongBatchResult) bool { return result.Err != nil }) {
+			return errBatchRollback
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBatchRollback) {
+		return SongBatchResult{}, err
+	}
+
+	return SongBatchResult{
+		Results:   results,
+		Committed: err == nil,
+	}, nil
+}
+
 func (s *SongService) UpdateSong(ctx context.Context, song models.Song) (models.Song, error) {
 	ctx, span := s.tracer.Start(ctx, "SongService.UpdateSong")
 	defer span.End()
 
+	song = normalizeSong(song)
+
+	if err := validation.ValidateSong(song); err != nil {
+		return models.Song{}, err
+	}
+
 	updatedSong, err := s.repository.Update(ctx, song)
 	if err != nil {
 		return models.Song{}, err
@@ -47,7 +135,42 @@ func (s *SongService) UpdateSong(ctx context.Context, song models.Song) (models.
 	return updatedSong, nil
 }
 
-func (s *SongService) Song(ctx context.Context, id uuid.UUID, pagination *repo.Pagination) (models.Song, error) {
+// PatchSong applies the patch to the JSON representation of the stored song and saves the
+// result, locking the song for the duration. If version is set, the stored song must have it.
+func (s *SongService) PatchSong(ctx context.Context, id uuid.UUID, version int32, patch jsonpatch.Patcher) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.PatchSong")
+	defer span.End()
+
+	var patchedSong models.Song
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.lockSong(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		song, err = applySongPatch(song, patch)
+		if err != nil {
+			return err
+		}
+
+		song = normalizeSong(song)
+
+		if err := validation.ValidateSongPatch(song); err != nil {
+			return err
+		}
+
+		patchedSong, err = s.repository.Update(ctx, song)
+
+		return err
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return patchedSong, nil
+}
+
+func (s *SongService) Song(ctx context.Context, id uuid.UUID) (models.Song, error) {
 	ctx, span := s.tracer.Start(ctx, "SongService.Song")
 	defer span.End()
 
@@ -56,23 +179,333 @@ func (s *SongService) Song(ctx context.Context, id uuid.UUID, pagination *repo.P
 		return models.Song{}, err
 	}
 
-	if pagination != nil {
-		song = addTextPagination(song, *pagination)
+	return song, nil
+}
+
+// SongVerses returns the song with its text limited to the page of verses, along with the
+// page itself. The text is taken from the translation that best matches the language
+// preference, a language tag or an Accept-Language value, or from the original text if no
+// translation matches. With collapseRepeats repeated verses are replaced by repeat markers
+// before the page is taken. An offset past the last verse is an ErrVerseOutOfRange.
+func (s *SongService) SongVerses(ctx context.Context, id uuid.UUID, pagination repo.Pagination, collapseRepeats bool, language string) (models.Song, models.VersePage, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongVerses")
+	defer span.End()
+
+	song, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return models.Song{}, models.VersePage{}, err
 	}
 
-	return song, nil
+	song, language, err = s.translate(ctx, song, language)
+	if err != nil {
+		return models.Song{}, models.VersePage{}, err
+	}
+
+	verses := songVerses(song)
+	if collapseRepeats {
+		verses = lyrics.Collapse(verses)
+	}
+
+	page, err := paginateVerses(verses, pagination)
+	if err != nil {
+		return models.Song{}, models.VersePage{}, err
+	}
+
+	page.Language = language
+
+	// The whole text is returned as stored, joining parsed verses could move section labels.
+	if collapseRepeats || page.Offset > 0 || page.Limit > 0 {
+		song.Text = lyrics.Join(page.Verses)
+	}
+
+	return song, page, nil
 }
 
-func (s *SongService) SongList(ctx context.Context, filter *repo.SongFilter, pagination *repo.Pagination) ([]models.Song, error) {
-	ctx, span := s.tracer.Start(ctx, "SongService.SongList")
+// SongVerse returns the verse of the song with the given index as a page of one verse, which
+// also holds the total number of verses. The verse is taken from the translation that best
+// matches the language preference, as for SongVerses.
+func (s *SongService) SongVerse(ctx context.Context, id uuid.UUID, index int, language string) (models.VersePage, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongVerse")
 	defer span.End()
 
-	songList, err := s.repository.List(ctx, filter, pagination)
+	song, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return models.VersePage{}, err
+	}
+
+	song, language, err = s.translate(ctx, song, language)
 	if err != nil {
+		return models.VersePage{}, err
+	}
+
+	verses := songVerses(song)
+
+	if index < 0 || index >= len(verses) {
+		return models.VersePage{}, fmt.Errorf("%w: song with id = %s has %d verses, verse %d not found", repo.ErrObjectNotFound, id.String(), len(verses), index)
+	}
+
+	page := models.VersePage{
+		Verses:   verses[index : index+1],
+		Total:    len(verses),
+		Offset:   index,
+		Limit:    1,
+		HasMore:  index+1 < len(verses),
+		Language: language,
+	}
+
+	return page, nil
+}
+
+func (s *SongService) SongTranslations(ctx context.Context, id uuid.UUID) ([]models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongTranslations")
+	defer span.End()
+
+	if _, err := s.repository.GetByID(ctx, id); err != nil {
 		return nil, err
 	}
 
-	return songList, nil
+	translations, err := s.repository.ListTranslations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+func (s *SongService) SongTranslation(ctx context.Context, id uuid.UUID, language string) (models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongTranslation")
+	defer span.End()
+
+	if _, err := s.repository.GetByID(ctx, id); err != nil {
+		return models.SongTranslation{}, err
+	}
+
+	translation, err := s.repository.GetTranslation(ctx, id, language)
+	if err != nil {
+		return models.SongTranslation{}, err
+	}
+
+	return translation, nil
+}
+
+// SaveSongTranslation adds or replaces the translation of the song to the language of the
+// translation. The song version is bumped, as the song is served in the translation to
+// clients preferring its language. If version is set, the stored song must have it.
+func (s *SongService) SaveSongTranslation(ctx context.Context, id uuid.UUID, version int32, translation models.SongTranslation) (models.Song, models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SaveSongTranslation")
+	defer span.End()
+
+	translation.Text = lyrics.Normalize(translation.Text)
+
+	if err := validation.ValidateTranslation(translation); err != nil {
+		return models.Song{}, models.SongTranslation{}, err
+	}
+
+	language, err := lyrics.CanonicalLanguage(translation.Language)
+	if err != nil {
+		return models.Song{}, models.SongTranslation{}, err
+	}
+
+	translation.Language = language
+
+	var (
+		updatedSong        models.Song
+		updatedTranslation models.SongTranslation
+	)
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.lockSong(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		if updatedTranslation, err = s.repository.SaveTranslation(ctx, id, translation); err != nil {
+			return err
+		}
+
+		updatedSong = song
+		updatedSong.Version, err = s.repository.IncrementVersion(ctx, id)
+
+		return err
+	}); err != nil {
+		return models.Song{}, models.SongTranslation{}, err
+	}
+
+	return updatedSong, updatedTranslation, nil
+}
+
+// DeleteSongTranslation deletes the translation of the song to the language and bumps the
+// song version. If version is set, the stored song must have it.
+func (s *SongService) DeleteSongTranslation(ctx context.Context, id uuid.UUID, version int32, language string) (models.Song, models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.DeleteSongTranslation")
+	defer span.End()
+
+	var (
+		updatedSong        models.Song
+		deletedTranslation models.SongTranslation
+	)
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.lockSong(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		if deletedTranslation, err = s.repository.DeleteTranslation(ctx, id, language); err != nil {
+			return err
+		}
+
+		updatedSong = song
+		updatedSong.Version, err = s.repository.IncrementVersion(ctx, id)
+
+		return err
+	}); err != nil {
+		return models.Song{}, models.SongTranslation{}, err
+	}
+
+	return updatedSong, deletedTranslation, nil
+}
+
+// UpdateSyncedLyrics saves the synced lyrics of the song and replaces the song text with
+// the text of the synced lyrics, so that clients without synced lyrics support keep working.
+// The song version is bumped even if the text stays the same, as the timings change.
+func (s *SongService) UpdateSyncedLyrics(ctx context.Context, id uuid.UUID, version int32, synced models.SyncedLyrics) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.UpdateSyncedLyrics")
+	defer span.End()
+
+	var updatedSong models.Song
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.lockSong(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		if text := lyrics.Normalize(synced.Text()); text != song.Text {
+			song.Text = text
+
+			if err := validation.ValidateSongPatch(song); err != nil {
+				return err
+			}
+		}
+
+		updatedSong, err = s.repository.SaveSyncedLyrics(ctx, song, synced)
+
+		return err
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return updatedSong, nil
+}
+
+func (s *SongService) SyncedLyrics(ctx context.Context, id uuid.UUID) (models.SyncedLyrics, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SyncedLyrics")
+	defer span.End()
+
+	synced, err := s.repository.GetSyncedLyrics(ctx, id)
+	if err != nil {
+		return models.SyncedLyrics{}, err
+	}
+
+	return synced, nil
+}
+
+type SongListResult struct {
+	SongList   []models.SongMatch
+	NextCursor *repo.Cursor
+	PrevCursor *repo.Cursor
+	HasMore    bool
+	Total      *int64
+}
+
+// SongList returns a page of songs. If includeTotal is set, the number of songs matching
+// the filter is counted in the same snapshot as the page.
+func (s *SongService) SongList(ctx context.Context, filter *repo.SongFilter, pagination *repo.Pagination, includeTotal bool) (SongListResult, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongList")
+	defer span.End()
+
+	var page repo.Pagination
+
+	if pagination != nil {
+		page = *pagination
+	}
+
+	page.Sort = repo.EffectiveSongSort(filter, page.Sort)
+
+	limit := page.Limit
+	if limit > 0 {
+		page.Limit = limit + 1
+	}
+
+	var (
+		songList []models.SongMatch
+		total    *int64
+	)
+
+	if err := s.txManager.WithSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+
+		songList, err = s.repository.List(ctx, filter, &page)
+		if err != nil {
+			return err
+		}
+
+		if !includeTotal {
+			return nil
+		}
+
+		count, err := s.repository.Count(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		total = &count
+
+		return nil
+	}); err != nil {
+		return SongListResult{}, err
+	}
+
+	var (
+		backward = page.Cursor != nil && page.Cursor.Backward
+		hasMore  = limit > 0 && len(songList) > int(limit)
+	)
+
+	if hasMore {
+		if backward {
+			songList = songList[1:]
+		} else {
+			songList = songList[:limit]
+		}
+	}
+
+	result := SongListResult{
+		SongList: songList,
+		HasMore:  hasMore || (backward && len(songList) > 0),
+		Total:    total,
+	}
+
+	if len(songList) == 0 {
+		return result, nil
+	}
+
+	var (
+		first = songList[0]
+		last  = songList[len(songList)-1]
+	)
+
+	if hasMore || backward {
+		nextCursor := repo.NewSongCursor(last, page.Sort, false)
+		result.NextCursor = &nextCursor
+	}
+
+	if (backward && hasMore) || (!backward && (page.Cursor != nil || page.Offset > 0)) {
+		prevCursor := repo.NewSongCursor(first, page.Sort, true)
+		result.PrevCursor = &prevCursor
+	}
+
+	return result, nil
 }
 
 func (s *SongService) DeleteSong(ctx context.Context, id uuid.UUID) (*time.Time, error) {
@@ -87,25 +520,251 @@ func (s *SongService) DeleteSong(ctx context.Context, id uuid.UUID) (*time.Time,
 	return deletedTime, nil
 }
 
-func addTextPagination(song models.Song, pagination repo.Pagination) models.Song {
-	const delim = "\n\n"
+func (s *SongService) DeletedSongList(ctx context.Context, pagination *repo.Pagination) ([]models.DeletedSong, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.DeletedSongList")
+	defer span.End()
+
+	songList, err := s.repository.ListDeleted(ctx, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return songList, nil
+}
+
+func (s *SongService) RestoreSong(ctx context.Context, id uuid.UUID) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.RestoreSong")
+	defer span.End()
+
+	restoredSong, err := s.repository.Restore(ctx, id)
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	return restoredSong, nil
+}
+
+func (s *SongService) SongRevisions(ctx context.Context, id uuid.UUID) ([]models.SongRevision, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongRevisions")
+	defer span.End()
+
+	revisionList, err := s.repository.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionList, nil
+}
+
+func (s *SongService) SongRevision(ctx context.Context, id uuid.UUID, revision int32) (models.SongRevision, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongRevision")
+	defer span.End()
+
+	songRevision, err := s.repository.GetRevision(ctx, id, revision)
+	if err != nil {
+		return models.SongRevision{}, err
+	}
+
+	return songRevision, nil
+}
+
+// SongRevisionDiff compares two revisions of the song field by field, the lyrics are compared verse by verse.
+func (s *SongService) SongRevisionDiff(ctx context.Context, id uuid.UUID, from int32, to int32) (models.SongDiff, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.SongRevisionDiff")
+	defer span.End()
+
+	fromRevision, err := s.repository.GetRevision(ctx, id, from)
+	if err != nil {
+		return models.SongDiff{}, err
+	}
+
+	toRevision, err := s.repository.GetRevision(ctx, id, to)
+	if err != nil {
+		return models.SongDiff{}, err
+	}
+
+	return diffSongs(fromRevision, toRevision), nil
+}
+
+// RevertSong restores the fields of the song from the revision, locking the song for the
+// duration. Revisions may predate validation, so the restored song is validated as a patched
+// one. If version is set, the stored song must have it.
+func (s *SongService) RevertSong(ctx context.Context, id uuid.UUID, version int32, revision int32) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongService.RevertSong")
+	defer span.End()
+
+	var revertedSong models.Song
 
-	verseList := strings.Split(song.Text, delim)
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		song, err := s.lockSong(ctx, id, version)
+		if err != nil {
+			return err
+		}
 
-	if pagination.Offset >= int32(len(verseList)) {
-		song.Text = ""
-		return song
+		songRevision, err := s.repository.GetRevision(ctx, id, revision)
+		if err != nil {
+			return err
+		}
+
+		revertedSong = normalizeSong(songRevision.Song)
+		revertedSong.Version = song.Version
+
+		if err := validation.ValidateSongPatch(revertedSong); err != nil {
+			return err
+		}
+
+		revertedSong, err = s.repository.Revert(ctx, revertedSong)
+
+		return err
+	}); err != nil {
+		return models.Song{}, err
 	}
 
-	if pagination.Offset > 0 {
-		verseList = verseList[pagination.Offset:]
+	return revertedSong, nil
+}
+
+func diffSongs(from models.SongRevision, to models.SongRevision) models.SongDiff {
+	songDiff := models.SongDiff{
+		From:   from.Revision,
+		To:     to.Revision,
+		Fields: diffSongFields(from.Song, to.Song),
+		Verses: make([]models.VerseChange, 0),
 	}
 
-	if pagination.Limit <= 0 || pagination.Limit >= int32(len(verseList)) {
-		song.Text = strings.Join(verseList, delim)
-		return song
+	for _, edit := range diff.Compute(verseTexts(from.Song.Text), verseTexts(to.Song.Text)) {
+		songDiff.Verses = append(songDiff.Verses, models.VerseChange{Operation: string(edit.Operation), Text: edit.Value})
+	}
+
+	return songDiff
+}
+
+// diffSongFields returns the fields that differ between the two songs.
+func diffSongFields(from models.Song, to models.Song) []models.FieldChange {
+	changes := make([]models.FieldChange, 0)
+
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{name: "song", from: from.Name, to: to.Name},
+		{name: "group", from: from.Group, to: to.Group},
+		{name: "release_date", from: from.ReleaseDate.String(), to: to.ReleaseDate.String()},
+		{name: "text", from: from.Text, to: to.Text},
+		{name: "link", from: from.Link, to: to.Link},
+		{name: "language", from: from.Language, to: to.Language},
+	}
+
+	for _, field := range fields {
+		if field.from != field.to {
+			changes = append(changes, models.FieldChange{Field: field.name, From: field.from, To: field.to})
+		}
+	}
+
+	return changes
+}
+
+// lockSong returns the song locked until the end of the transaction. If version is set,
+// the song must have it.
+func (s *SongService) lockSong(ctx context.Context, id uuid.UUID, version int32) (models.Song, error) {
+	song, err := s.repository.GetByIDForUpdate(ctx, id)
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	if version != 0 && version != song.Version {
+		return models.Song{}, fmt.Errorf("%w: song with id = %s has version %d, expected %d", repo.ErrVersionMismatch, id, song.Version, version)
+	}
+
+	return song, nil
+}
+
+// translate replaces the text of the song with the translation that best matches the
+// language preference and returns the language of the translation. The song is returned
+// unchanged with an empty language if no translation matches or the original language
+// matches at least as well, e.g. "en,de;q=0.5" for an English song with a German translation.
+func (s *SongService) translate(ctx context.Context, song models.Song, preference string) (models.Song, string, error) {
+	if preference == "" {
+		return song, "", nil
+	}
+
+	translations, err := s.repository.ListTranslations(ctx, song.ID)
+	if err != nil {
+		return models.Song{}, "", err
+	}
+
+	languages := make([]string, 0, len(translations)+1)
+	if song.Language != "" {
+		languages = append(languages, song.Language)
+	}
+
+	for _, translation := range translations {
+		languages = append(languages, translation.Language)
+	}
+
+	language, ok := lyrics.MatchLanguage(languages, preference)
+	if !ok || language == song.Language {
+		return song, "", nil
+	}
+
+	translation := translations[slices.IndexFunc(translations, func(translation models.SongTranslation) bool { return translation.Language == language })]
+
+	song.Text = translation.Text
+	song.Structure = translation.Structure
+
+	return song, language, nil
+}
+
+// normalizeSong brings the text and the language tag of the song to the form they are
+// stored in. A language that is not a valid tag is kept for the validation to report.
+func normalizeSong(song models.Song) models.Song {
+	song.Text = lyrics.Normalize(song.Text)
+
+	if language, err := lyrics.CanonicalLanguage(song.Language); err == nil && song.Language != "" {
+		song.Language = language
 	}
 
-	song.Text = strings.Join(verseList[:pagination.Limit], delim)
 	return song
 }
+
+// verseTexts returns the text of every verse of the song text.
+func verseTexts(text string) []string {
+	verses := lyrics.Parse(text)
+
+	texts := make([]string, 0, len(verses))
+	for _, verse := range verses {
+		texts = append(texts, verse.Text())
+	}
+
+	return texts
+}
+
+// songVerses returns the verses of the song text with their detected structure.
+func songVerses(song models.Song) []models.Verse {
+	return lyrics.Annotate(lyrics.Parse(song.Text), song.Structure)
+}
+
+// paginateVerses returns the page of verses. Negative offsets and limits are treated as
+// zero, a zero limit returns every remaining verse. An offset past the last verse is an
+// ErrVerseOutOfRange, a song without verses only has the page at offset zero.
+func paginateVerses(verses []models.Verse, pagination repo.Pagination) (models.VersePage, error) {
+	page := models.VersePage{
+		Verses: verses,
+		Total:  len(verses),
+		Offset: max(int(pagination.Offset), 0),
+		Limit:  max(int(pagination.Limit), 0),
+	}
+
+	if page.Offset > 0 && page.Offset >= page.Total {
+		return models.VersePage{}, fmt.Errorf("%w: offset %d, song has %d verses", ErrVerseOutOfRange, page.Offset, page.Total)
+	}
+
+	page.Verses = page.Verses[page.Offset:]
+
+	if page.Limit > 0 && page.Limit < len(page.Verses) {
+		page.Verses = page.Verses[:page.Limit]
+	}
+
+	page.HasMore = page.Offset+len(page.Verses) < page.Total
+
+	return page, nil
+}
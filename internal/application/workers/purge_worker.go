@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"song-service/internal/application/services"
+	"time"
+)
+
+// PurgeWorker periodically hard deletes songs and groups whose retention period has expired.
+type PurgeWorker struct {
+	purgeService *services.PurgeService
+	interval     time.Duration
+	logger       *slog.Logger
+}
+
+func NewPurgeWorker(purgeService *services.PurgeService, interval time.Duration, logger *slog.Logger) *PurgeWorker {
+	return &PurgeWorker{
+		purgeService: purgeService,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run purges expired rows right away and then every interval until ctx is cancelled.
+func (w *PurgeWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PurgeWorker) purge(ctx context.Context) {
+	result, err := w.purgeService.Purge(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		w.logger.Warn("purge deleted rows failed", slog.String("error", err.Error()))
+	}
+
+	if result.Songs > 0 || result.Groups > 0 || result.CacheEntries > 0 {
+		w.logger.Info("purged deleted rows", slog.Int64("songs", result.Songs), slog.Int64("groups", result.Groups), slog.Int64("cache_entries", result.CacheEntries))
+	}
+}
@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"song-service/internal/application/services"
+	"time"
+)
+
+// RefreshWorker periodically refreshes songs not refreshed from the song info providers for too long.
+type RefreshWorker struct {
+	refreshService *services.SongRefreshService
+	interval       time.Duration
+	logger         *slog.Logger
+}
+
+func NewRefreshWorker(refreshService *services.SongRefreshService, interval time.Duration, logger *slog.Logger) *RefreshWorker {
+	return &RefreshWorker{
+		refreshService: refreshService,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+// Run refreshes stale songs right away and then every interval until ctx is cancelled.
+func (w *RefreshWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *RefreshWorker) refresh(ctx context.Context) {
+	result, err := w.refreshService.RefreshStale(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		w.logger.Warn("refresh stale songs failed", slog.String("error", err.Error()))
+	}
+
+	if result.Refreshed > 0 || result.Failed > 0 {
+		w.logger.Info("refreshed stale songs", slog.Int64("refreshed", result.Refreshed), slog.Int64("updated", result.Updated), slog.Int64("failed", result.Failed))
+	}
+}
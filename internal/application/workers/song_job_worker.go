@@ -0,0 +1,127 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"song-service/internal/application/providers"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/application/services"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"sync"
+	"time"
+)
+
+// SongJobWorker processes queued song jobs, fetching song details from the song info provider.
+type SongJobWorker struct {
+	jobService       *services.SongJobService
+	songInfoProvider providers.SongInfoProvider
+	workers          int
+	pollInterval     time.Duration
+	logger           *slog.Logger
+}
+
+func NewSongJobWorker(jobService *services.SongJobService, songInfoProvider providers.SongInfoProvider, workers int, pollInterval time.Duration, logger *slog.Logger) *SongJobWorker {
+	return &SongJobWorker{
+		jobService:       jobService,
+		songInfoProvider: songInfoProvider,
+		workers:          workers,
+		pollInterval:     pollInterval,
+		logger:           logger,
+	}
+}
+
+// Run starts the configured number of workers and blocks until ctx is cancelled
+// and all of them have returned.
+func (w *SongJobWorker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for range w.workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			w.poll(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// poll processes jobs one at a time, waiting for the poll interval whenever the queue is empty.
+func (w *SongJobWorker) poll(ctx context.Context) {
+	for {
+		jobList, err := w.jobService.ClaimSongJobs(ctx, 1)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			w.logger.Warn("claim song jobs failed", slog.String("error", err.Error()))
+		}
+
+		for _, job := range jobList {
+			w.process(ctx, job)
+		}
+
+		if len(jobList) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+func (w *SongJobWorker) process(ctx context.Context, job models.SongJob) {
+	logger := w.logger.With(slog.String("job_id", job.ID.String()), slog.Int("attempt", int(job.Attempts)))
+
+	songInfo, err := w.songInfoProvider.Info(ctx, job.Group, job.Song)
+	if err != nil {
+		// The job is claimed again once its lease expires.
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.fail(ctx, logger, job, err, !providers.Permanent(err))
+		return
+	}
+
+	song := models.Song{
+		Name:        job.Song,
+		Group:       job.Group,
+		ReleaseDate: songInfo.ReleaseDate,
+		Text:        songInfo.Text,
+		Link:        songInfo.Link,
+	}
+
+	createdSong, err := w.jobService.CompleteSongJob(ctx, job, song)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if errors.Is(err, repo.ErrObjectNotFound) {
+			logger.Warn("song job lease lost", slog.String("error", err.Error()))
+			return
+		}
+
+		var validationErr *validation.Error
+
+		w.fail(ctx, logger, job, err, !errors.Is(err, repo.ErrDuplicate) && !errors.As(err, &validationErr))
+		return
+	}
+
+	logger.Info("song job succeeded", slog.String("song_id", createdSong.ID.String()))
+}
+
+func (w *SongJobWorker) fail(ctx context.Context, logger *slog.Logger, job models.SongJob, cause error, retryable bool) {
+	failedJob, err := w.jobService.FailSongJob(ctx, job, cause.Error(), retryable)
+	if err != nil {
+		logger.Warn("fail song job failed", slog.String("error", err.Error()))
+		return
+	}
+
+	logger.Warn("song job failed", slog.String("status", failedJob.Status), slog.String("error", cause.Error()))
+}
@@ -0,0 +1,58 @@
+package lyrics
+
+import (
+	"golang.org/x/text/language"
+)
+
+// CanonicalLanguage returns the canonical form of a BCP 47 language tag, e.g. "pt-BR"
+// for "pt_br", so that a translation is stored once per language.
+func CanonicalLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.String(), nil
+}
+
+// LanguageFallbacks returns the canonical form of the tag followed by the more general
+// tags it falls back to, e.g. "de-CH" and "de" for "de-CH".
+func LanguageFallbacks(tag string) ([]string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var fallbacks []string
+	for ; parsed != language.Und; parsed = parsed.Parent() {
+		fallbacks = append(fallbacks, parsed.String())
+	}
+
+	return fallbacks, nil
+}
+
+// MatchLanguage returns the available language that best matches the preference, which is
+// a language tag or an Accept-Language header value. A preference without a close enough
+// match, e.g. "fr" when only "de" is available, matches nothing.
+func MatchLanguage(available []string, preference string) (string, bool) {
+	if len(available) == 0 || preference == "" {
+		return "", false
+	}
+
+	preferred, _, err := language.ParseAcceptLanguage(preference)
+	if err != nil || len(preferred) == 0 {
+		return "", false
+	}
+
+	tags := make([]language.Tag, 0, len(available))
+	for _, tag := range available {
+		tags = append(tags, language.Make(tag))
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(preferred...)
+	if confidence == language.No {
+		return "", false
+	}
+
+	return available[index], true
+}
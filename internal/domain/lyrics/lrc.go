@@ -0,0 +1,319 @@
+package lyrics
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tagOffset shifts every timestamp of the lyrics, a positive offset makes lines appear
+// sooner. It is applied on parsing and not kept in the tags.
+const tagOffset = "offset"
+
+var (
+	// lrcTimestampPattern matches the content of a time tag such as "01:23.45", the
+	// fraction may be tenths, hundredths or milliseconds.
+	lrcTimestampPattern = regexp.MustCompile(`^(\d+):(\d{1,2})(?:[.:](\d{1,3}))?$`)
+	// lrcTagPattern matches the content of a metadata tag such as "ar:Artist".
+	lrcTagPattern = regexp.MustCompile(`^([a-zA-Z#]+):(.*)$`)
+)
+
+// ParseLRC parses LRC or enhanced LRC lyrics. A line with several time tags is repeated
+// at each of them, and lines are ordered by time. Every malformed line is reported in the
+// returned validation error.
+func ParseLRC(lrc string) (models.SyncedLyrics, error) {
+	var (
+		synced = models.SyncedLyrics{Tags: make(map[string]string), Lines: make([]models.SyncedLine, 0)}
+		errs   = make([]validation.FieldError, 0)
+		offset int64
+	)
+
+	addErr := func(lineNumber int, code string, message string) {
+		errs = append(errs, validation.FieldError{
+			Field:   validation.FieldLyrics,
+			Code:    code,
+			Message: fmt.Sprintf("line %d: %s", lineNumber, message),
+		})
+	}
+
+	lrc = strings.ReplaceAll(lrc, "\r\n", "\n")
+	lrc = strings.ReplaceAll(lrc, "\r", "\n")
+
+	for i, rawLine := range strings.Split(lrc, "\n") {
+		lineNumber := i + 1
+
+		rawLine = strings.TrimSpace(rawLine)
+		if rawLine == "" {
+			continue
+		}
+
+		times, rest, ok := parseTimeTags(rawLine)
+		if !ok {
+			addErr(lineNumber, validation.CodeInvalidTimestamp, "has an invalid time tag")
+			continue
+		}
+
+		if len(times) == 0 {
+			key, value, isTag := parseMetadataTag(rawLine)
+			if !isTag {
+				addErr(lineNumber, validation.CodeMissingTimestamp, "must start with a time tag")
+				continue
+			}
+
+			if key != tagOffset {
+				synced.Tags[key] = value
+				continue
+			}
+
+			var err error
+			if offset, err = strconv.ParseInt(value, 10, 64); err != nil {
+				addErr(lineNumber, validation.CodeInvalidTag, "offset must be a number of milliseconds")
+			}
+
+			continue
+		}
+
+		text, words, ok := parseWords(rest, times[0])
+		if !ok {
+			addErr(lineNumber, validation.CodeInvalidTimestamp, "has invalid or decreasing word timestamps")
+			continue
+		}
+
+		for _, lineTime := range times {
+			line := models.SyncedLine{Time: lineTime, Text: text}
+
+			if len(words) > 0 {
+				line.Words = make([]models.SyncedWord, 0, len(words))
+				for _, word := range words {
+					line.Words = append(line.Words, models.SyncedWord{Time: word.Time - times[0] + lineTime, Text: word.Text})
+				}
+			}
+
+			synced.Lines = append(synced.Lines, line)
+		}
+	}
+
+	if len(errs) == 0 && len(synced.Lines) == 0 {
+		errs = append(errs, validation.FieldError{
+			Field:   validation.FieldLyrics,
+			Code:    validation.CodeRequired,
+			Message: "must contain at least one timed line",
+		})
+	}
+
+	if len(errs) > 0 {
+		return models.SyncedLyrics{}, &validation.Error{Fields: errs}
+	}
+
+	if offset != 0 {
+		shiftLines(synced.Lines, -offset)
+	}
+
+	slices.SortStableFunc(synced.Lines, func(a, b models.SyncedLine) int {
+		return cmp.Compare(a.Time, b.Time)
+	})
+
+	return synced, nil
+}
+
+// FormatLRC returns the lyrics in LRC, or in enhanced LRC for lines with word timestamps.
+func FormatLRC(synced models.SyncedLyrics) string {
+	var builder strings.Builder
+
+	keys := make([]string, 0, len(synced.Tags))
+	for key := range synced.Tags {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(&builder, "[%s:%s]\n", key, synced.Tags[key])
+	}
+
+	for _, line := range synced.Lines {
+		builder.WriteString("[" + formatLRCTime(line.Time) + "]")
+
+		if len(line.Words) == 0 {
+			builder.WriteString(line.Text)
+		} else {
+			for _, word := range line.Words {
+				builder.WriteString("<" + formatLRCTime(word.Time) + ">" + word.Text)
+			}
+		}
+
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+// parseTimeTags parses the time tags at the start of the line and returns the rest of it.
+// A line starting with a tag that is neither a time nor a metadata tag is invalid.
+func parseTimeTags(line string) ([]int64, string, bool) {
+	times := make([]int64, 0)
+
+	for strings.HasPrefix(line, "[") {
+		end := strings.IndexByte(line, ']')
+		if end < 0 {
+			return nil, "", false
+		}
+
+		lineTime, ok := parseLRCTime(line[1:end])
+		if !ok {
+			if len(times) == 0 && lrcTagPattern.MatchString(line[1:end]) {
+				return times, line, true
+			}
+
+			return nil, "", false
+		}
+
+		times = append(times, lineTime)
+		line = line[end+1:]
+	}
+
+	return times, strings.TrimSpace(line), true
+}
+
+// parseMetadataTag parses a line consisting of a single metadata tag.
+func parseMetadataTag(line string) (string, string, bool) {
+	if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+		return "", "", false
+	}
+
+	match := lrcTagPattern.FindStringSubmatch(line[1 : len(line)-1])
+	if match == nil {
+		return "", "", false
+	}
+
+	return strings.ToLower(match[1]), strings.TrimSpace(match[2]), true
+}
+
+// parseWords splits the text of an enhanced LRC line into words at word timestamps such
+// as "<01:23.45>". A word keeps the whitespace following it, so that syllables, e.g.
+// "<00:01.00>Hel<00:01.20>lo", join back into the line text. Text before the first word
+// timestamp starts at the line time, angle brackets that don't hold a timestamp are kept
+// as text. Without word timestamps no words are returned.
+func parseWords(text string, lineTime int64) (string, []models.SyncedWord, bool) {
+	var (
+		words    = make([]models.SyncedWord, 0)
+		wordTime = lineTime
+		timed    bool
+		word     strings.Builder
+	)
+
+	flush := func() {
+		fragment := word.String()
+		word.Reset()
+
+		switch {
+		case strings.TrimSpace(fragment) != "":
+			if len(words) == 0 {
+				fragment = strings.TrimLeftFunc(fragment, unicode.IsSpace)
+			}
+
+			words = append(words, models.SyncedWord{Time: wordTime, Text: fragment})
+		case fragment != "" && len(words) > 0:
+			// Whitespace between two timestamps separates the words around it.
+			words[len(words)-1].Text += fragment
+		}
+	}
+
+	for text != "" {
+		start := strings.IndexByte(text, '<')
+		if start < 0 {
+			word.WriteString(text)
+			break
+		}
+
+		word.WriteString(text[:start])
+		text = text[start:]
+
+		end := strings.IndexByte(text, '>')
+		if end < 0 {
+			word.WriteString(text)
+			break
+		}
+
+		nextTime, ok := parseLRCTime(text[1:end])
+		if !ok {
+			word.WriteString(text[:1])
+			text = text[1:]
+
+			continue
+		}
+
+		if nextTime < wordTime {
+			return "", nil, false
+		}
+
+		flush()
+
+		wordTime = nextTime
+		timed = true
+		text = text[end+1:]
+	}
+
+	if !timed {
+		return strings.TrimSpace(word.String()), nil, true
+	}
+
+	flush()
+
+	if len(words) == 0 {
+		return "", nil, true
+	}
+
+	last := &words[len(words)-1]
+	last.Text = strings.TrimRightFunc(last.Text, unicode.IsSpace)
+
+	var lineText strings.Builder
+	for _, word := range words {
+		lineText.WriteString(word.Text)
+	}
+
+	return lineText.String(), words, true
+}
+
+// parseLRCTime parses the content of a time tag into milliseconds.
+func parseLRCTime(value string) (int64, bool) {
+	match := lrcTimestampPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, false
+	}
+
+	minutes, _ := strconv.ParseInt(match[1], 10, 64)
+	seconds, _ := strconv.ParseInt(match[2], 10, 64)
+
+	if seconds >= 60 {
+		return 0, false
+	}
+
+	var millis int64
+	if fraction := match[3]; fraction != "" {
+		millis, _ = strconv.ParseInt((fraction + "00")[:3], 10, 64)
+	}
+
+	return (minutes*60+seconds)*1000 + millis, true
+}
+
+// formatLRCTime formats milliseconds as mm:ss.xx.
+func formatLRCTime(millis int64) string {
+	return fmt.Sprintf("%02d:%02d.%02d", millis/60000, millis/1000%60, millis%1000/10)
+}
+
+func shiftLines(lines []models.SyncedLine, shift int64) {
+	for i := range lines {
+		lines[i].Time = max(lines[i].Time+shift, 0)
+
+		for j := range lines[i].Words {
+			lines[i].Words[j].Time = max(lines[i].Words[j].Time+shift, 0)
+		}
+	}
+}
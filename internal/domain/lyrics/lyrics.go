@@ -0,0 +1,135 @@
+package lyrics
+
+import (
+	"regexp"
+	"song-service/internal/domain/models"
+	"strings"
+	"unicode"
+)
+
+// VerseDelimiter separates verses in normalized song text.
+const VerseDelimiter = "\n\n"
+
+// labelPattern matches section header lines such as "[Chorus]", "(Verse 2)" or "Bridge:".
+var labelPattern = regexp.MustCompile(`^(?:\[\s*([^\]]+?)\s*\]|\(\s*([^)]+?)\s*\)|([^:]+?)\s*:)$`)
+
+// labelTypes maps section names, without their number, to verse types.
+var labelTypes = map[string]models.VerseType{
+	"verse":      models.VerseTypeVerse,
+	"куплет":     models.VerseTypeVerse,
+	"chorus":     models.VerseTypeChorus,
+	"refrain":    models.VerseTypeChorus,
+	"hook":       models.VerseTypeChorus,
+	"припев":     models.VerseTypeChorus,
+	"pre-chorus": models.VerseTypePreChorus,
+	"prechorus":  models.VerseTypePreChorus,
+	"pre chorus": models.VerseTypePreChorus,
+	"предприпев": models.VerseTypePreChorus,
+	"bridge":     models.VerseTypeBridge,
+	"бридж":      models.VerseTypeBridge,
+	"intro":      models.VerseTypeIntro,
+	"вступление": models.VerseTypeIntro,
+	"outro":      models.VerseTypeOutro,
+	"концовка":   models.VerseTypeOutro,
+}
+
+// Normalize converts line endings to "\n", strips trailing whitespace from lines and
+// separates verses with exactly one blank line, without blank lines around the text.
+func Normalize(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	var (
+		builder strings.Builder
+		blank   bool
+	)
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+
+		if line == "" {
+			blank = builder.Len() > 0
+			continue
+		}
+
+		switch {
+		case blank:
+			builder.WriteString(VerseDelimiter)
+		case builder.Len() > 0:
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString(line)
+		blank = false
+	}
+
+	return builder.String()
+}
+
+// Parse splits song text into verses. A verse ends at a blank line or at a section header
+// line, which also sets the type of the verse that follows. Verses without a header are
+// plain verses.
+func Parse(text string) []models.Verse {
+	var (
+		verses  = make([]models.Verse, 0)
+		current *models.Verse
+	)
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+
+		current.Index = len(verses)
+		verses = append(verses, *current)
+		current = nil
+	}
+
+	for _, line := range strings.Split(Normalize(text), "\n") {
+		if line == "" {
+			flush()
+			continue
+		}
+
+		if verseType, ok := labelType(line); ok {
+			flush()
+
+			current = &models.Verse{Type: verseType, Label: line, Lines: make([]string, 0)}
+			continue
+		}
+
+		if current == nil {
+			current = &models.Verse{Type: models.VerseTypeVerse, Lines: make([]string, 0)}
+		}
+
+		current.Lines = append(current.Lines, line)
+	}
+
+	flush()
+
+	return verses
+}
+
+// Join returns the song text of the verses.
+func Join(verses []models.Verse) string {
+	texts := make([]string, 0, len(verses))
+	for _, verse := range verses {
+		texts = append(texts, verse.Text())
+	}
+
+	return strings.Join(texts, VerseDelimiter)
+}
+
+func labelType(line string) (models.VerseType, bool) {
+	match := labelPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return "", false
+	}
+
+	name := strings.ToLower(match[1] + match[2] + match[3])
+	name = strings.TrimRightFunc(name, func(r rune) bool { return unicode.IsDigit(r) || unicode.IsSpace(r) })
+
+	verseType, ok := labelTypes[name]
+
+	return verseType, ok
+}
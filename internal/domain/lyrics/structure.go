@@ -0,0 +1,170 @@
+package lyrics
+
+import (
+	"slices"
+	"song-service/internal/domain/models"
+	"song-service/internal/pkg/diff"
+	"strings"
+	"unicode"
+)
+
+// similarityThreshold is the share of common words above which two stanzas are considered
+// the same section sung with small differences.
+const similarityThreshold = 0.8
+
+// sectionNames are used to build repeat markers for verses without a section header.
+var sectionNames = map[models.VerseType]string{
+	models.VerseTypeVerse:     "Verse",
+	models.VerseTypeChorus:    "Chorus",
+	models.VerseTypePreChorus: "Pre-Chorus",
+	models.VerseTypeBridge:    "Bridge",
+	models.VerseTypeIntro:     "Intro",
+	models.VerseTypeOutro:     "Outro",
+}
+
+// Analyze detects repeated verses. A verse repeats the first earlier verse with the same
+// words, ignoring case and punctuation, or with almost the same words. Repeated sections
+// keep the type of a header given to any of their stanzas and are choruses otherwise.
+// A header without lines, e.g. a lone "[Chorus]", repeats the first section of its type.
+func Analyze(verses []models.Verse) []models.VerseStructure {
+	var (
+		structure = make([]models.VerseStructure, len(verses))
+		words     = make([][]string, len(verses))
+		sections  = make([]int, 0)
+		repeats   = make(map[int][]int)
+	)
+
+	for i, verse := range verses {
+		structure[i].Type = verse.Type
+		words[i] = verseWords(verse)
+
+		var (
+			repeatOf int
+			ok       bool
+		)
+
+		if len(words[i]) == 0 {
+			repeatOf, ok = findSectionOfType(verses, sections, verse.Type)
+		} else {
+			repeatOf, ok = findSimilarSection(words, sections, words[i])
+		}
+
+		if !ok {
+			sections = append(sections, i)
+			continue
+		}
+
+		structure[i].RepeatOf = &repeatOf
+		repeats[repeatOf] = append(repeats[repeatOf], i)
+	}
+
+	for section, sectionRepeats := range repeats {
+		sectionType := models.VerseTypeChorus
+
+		for _, i := range append([]int{section}, sectionRepeats...) {
+			if verses[i].Type != models.VerseTypeVerse {
+				sectionType = verses[i].Type
+				break
+			}
+		}
+
+		structure[section].Type = sectionType
+		for _, i := range sectionRepeats {
+			structure[i].Type = sectionType
+		}
+	}
+
+	return structure
+}
+
+// Annotate sets the detected types and repeats of the verses. A structure that doesn't
+// match the verses, e.g. one saved before detection existed, is detected again.
+func Annotate(verses []models.Verse, structure []models.VerseStructure) []models.Verse {
+	if len(structure) != len(verses) {
+		structure = Analyze(verses)
+	}
+
+	annotated := make([]models.Verse, len(verses))
+	for i, verse := range verses {
+		verse.Type = structure[i].Type
+		verse.RepeatOf = structure[i].RepeatOf
+
+		annotated[i] = verse
+	}
+
+	return annotated
+}
+
+// Collapse replaces the lines of repeated verses with a repeat marker, so that each
+// section is returned once. The marker is the header of the repeat or the section name.
+func Collapse(verses []models.Verse) []models.Verse {
+	collapsed := make([]models.Verse, len(verses))
+	for i, verse := range verses {
+		if verse.RepeatOf != nil {
+			if verse.Label == "" {
+				verse.Label = "[" + sectionNames[verse.Type] + "]"
+			}
+
+			verse.Lines = make([]string, 0)
+		}
+
+		collapsed[i] = verse
+	}
+
+	return collapsed
+}
+
+func findSimilarSection(words [][]string, sections []int, verseWords []string) (int, bool) {
+	for _, section := range sections {
+		if similar(words[section], verseWords) {
+			return section, true
+		}
+	}
+
+	return 0, false
+}
+
+func findSectionOfType(verses []models.Verse, sections []int, verseType models.VerseType) (int, bool) {
+	if verseType == models.VerseTypeVerse {
+		return 0, false
+	}
+
+	for _, section := range sections {
+		if verses[section].Type == verseType && len(verses[section].Lines) > 0 {
+			return section, true
+		}
+	}
+
+	return 0, false
+}
+
+// similar reports whether the words are the same or the longest common subsequence of
+// them covers at least similarityThreshold of both. Verses whose lengths differ too much
+// to reach the threshold are not compared.
+func similar(a []string, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+
+	if float64(2*min(len(a), len(b)))/float64(len(a)+len(b)) < similarityThreshold {
+		return false
+	}
+
+	if slices.Equal(a, b) {
+		return true
+	}
+
+	return float64(2*diff.CommonLength(a, b))/float64(len(a)+len(b)) >= similarityThreshold
+}
+
+// verseWords returns the lowercase words of the verse lines without punctuation.
+func verseWords(verse models.Verse) []string {
+	words := make([]string, 0)
+	for _, line := range verse.Lines {
+		words = append(words, strings.FieldsFunc(strings.ToLower(line), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+	}
+
+	return words
+}
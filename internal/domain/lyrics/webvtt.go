@@ -0,0 +1,87 @@
+package lyrics
+
+import (
+	"fmt"
+	"song-service/internal/domain/models"
+	"strings"
+)
+
+// lastCueDuration is how long the last line is shown when the lyrics don't have a length.
+const lastCueDuration = 5000
+
+const tagLength = "length"
+
+var webVTTEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// FormatWebVTT returns the lyrics as WebVTT cues. A line is shown until the next line with
+// a later time starts, instrumental breaks only end the line before them. Word timestamps
+// become cue timestamps for karaoke rendering.
+func FormatWebVTT(synced models.SyncedLyrics) string {
+	var builder strings.Builder
+
+	builder.WriteString("WEBVTT\n")
+
+	for i, line := range synced.Lines {
+		if line.Text == "" {
+			continue
+		}
+
+		end := cueEnd(synced, i)
+
+		builder.WriteString("\n")
+		builder.WriteString(formatWebVTTTime(line.Time) + " --> " + formatWebVTTTime(end) + "\n")
+		builder.WriteString(cueText(line, end) + "\n")
+	}
+
+	return builder.String()
+}
+
+func cueEnd(synced models.SyncedLyrics, i int) int64 {
+	start := synced.Lines[i].Time
+
+	for _, next := range synced.Lines[i+1:] {
+		if next.Time > start {
+			return next.Time
+		}
+	}
+
+	if length, ok := parseLength(synced.Tags[tagLength]); ok && length > start {
+		return length
+	}
+
+	return start + lastCueDuration
+}
+
+func cueText(line models.SyncedLine, end int64) string {
+	if len(line.Words) == 0 {
+		return webVTTEscaper.Replace(line.Text)
+	}
+
+	words := make([]string, 0, len(line.Words))
+	for _, word := range line.Words {
+		text := webVTTEscaper.Replace(word.Text)
+
+		// Cue timestamps have to be within the cue.
+		if word.Time > line.Time && word.Time < end {
+			text = "<" + formatWebVTTTime(word.Time) + ">" + text
+		}
+
+		words = append(words, text)
+	}
+
+	return strings.Join(words, "")
+}
+
+// parseLength parses the length tag, e.g. "03:25", into milliseconds.
+func parseLength(value string) (int64, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	return parseLRCTime(strings.TrimSpace(value))
+}
+
+// formatWebVTTTime formats milliseconds as hh:mm:ss.ttt.
+func formatWebVTTTime(millis int64) string {
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", millis/3600000, millis/60000%60, millis/1000%60, millis%1000)
+}
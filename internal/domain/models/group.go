@@ -0,0 +1,11 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+type Group struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SongCount int64     `json:"song_count"`
+}
@@ -0,0 +1,84 @@
+package models
+
+import "strings"
+
+type VerseType string
+
+const (
+	VerseTypeVerse     VerseType = "verse"
+	VerseTypeChorus    VerseType = "chorus"
+	VerseTypePreChorus VerseType = "pre_chorus"
+	VerseTypeBridge    VerseType = "bridge"
+	VerseTypeIntro     VerseType = "intro"
+	VerseTypeOutro     VerseType = "outro"
+)
+
+// Verse is a stanza of song lyrics. Label is the section header line the stanza started
+// with, e.g. "[Chorus]", it isn't included in the lines. RepeatOf is the index of the
+// earlier verse the stanza repeats.
+type Verse struct {
+	Index    int       `json:"index"`
+	Type     VerseType `json:"type"                swaggertype:"string" enums:"verse,chorus,pre_chorus,bridge,intro,outro"`
+	Label    string    `json:"label,omitempty"`
+	Lines    []string  `json:"lines"`
+	RepeatOf *int      `json:"repeat_of,omitempty"`
+}
+
+// Text returns the verse as it appears in the song text.
+func (v Verse) Text() string {
+	if v.Label == "" {
+		return strings.Join(v.Lines, "\n")
+	}
+
+	return strings.Join(append([]string{v.Label}, v.Lines...), "\n")
+}
+
+// VerseStructure is the detected role of a verse in the song: its type and the earlier
+// verse it repeats, if any.
+type VerseStructure struct {
+	Type     VerseType `json:"type"`
+	RepeatOf *int      `json:"repeat_of,omitempty"`
+}
+
+// VersePage is a page of the verses of a song. Limit is zero for a page without a limit,
+// HasMore reports whether there are verses after the page. Language is the language of the
+// translation the verses are taken from, it is empty for the original text.
+type VersePage struct {
+	Verses   []Verse
+	Total    int
+	Offset   int
+	Limit    int
+	HasMore  bool
+	Language string
+}
+
+// SyncedLyrics are song lyrics with the time each line starts at. Tags are the LRC
+// metadata tags, e.g. "ar" for the artist.
+type SyncedLyrics struct {
+	Tags  map[string]string `json:"tags,omitempty"`
+	Lines []SyncedLine      `json:"lines"`
+}
+
+// SyncedLine is a line of lyrics starting at Time milliseconds from the start of the song.
+// Lines without text are instrumental breaks. Words are only set for enhanced LRC.
+type SyncedLine struct {
+	Time  int64        `json:"time_ms"`
+	Text  string       `json:"text"`
+	Words []SyncedWord `json:"words,omitempty"`
+}
+
+// SyncedWord is a word of a line starting at Time milliseconds from the start of the song.
+type SyncedWord struct {
+	Time int64  `json:"time_ms"`
+	Text string `json:"text"`
+}
+
+// Text returns the plain text of the lyrics, a line per synced line.
+func (l SyncedLyrics) Text() string {
+	lines := make([]string, 0, len(l.Lines))
+	for _, line := range l.Lines {
+		lines = append(lines, line.Text)
+	}
+
+	return strings.Join(lines, "\n")
+}
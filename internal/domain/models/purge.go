@@ -0,0 +1,7 @@
+package models
+
+type PurgeResult struct {
+	Songs        int64 `json:"songs"`
+	Groups       int64 `json:"groups"`
+	CacheEntries int64 `json:"cache_entries"`
+}
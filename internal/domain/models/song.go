@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/hardfinhq/go-date"
 )
@@ -12,4 +14,33 @@ type Song struct {
 	ReleaseDate date.Date `json:"release_date" swaggertype:"primitive,string"`
 	Text        string    `json:"text"`
 	Link        string    `json:"link"`
+	Version     int32     `json:"-"`
+
+	// Language is the BCP 47 tag of the original text, empty if it is unknown.
+	Language string `json:"language,omitempty"`
+
+	// Structure is detected from the text when the song is saved, one entry per verse.
+	Structure []VerseStructure `json:"-"`
+}
+
+type SongMatch struct {
+	Song
+	Rank       *float32 `json:"rank,omitempty"`
+	Headline   *string  `json:"headline,omitempty"`
+	Similarity *float32 `json:"similarity,omitempty"`
+}
+
+type DeletedSong struct {
+	Song
+	DeletedTime time.Time `json:"deleted_time"`
+}
+
+// SongTranslation is the song text in another language, identified by a BCP 47 tag.
+type SongTranslation struct {
+	Language    string    `json:"language"`
+	Text        string    `json:"text"`
+	UpdatedTime time.Time `json:"updated_time"`
+
+	// Structure is detected from the text when the translation is saved, one entry per verse.
+	Structure []VerseStructure `json:"-"`
 }
@@ -0,0 +1,10 @@
+package models
+
+import "github.com/hardfinhq/go-date"
+
+// SongInfo is song metadata provided by an external source.
+type SongInfo struct {
+	ReleaseDate date.Date `json:"release_date"`
+	Text        string    `json:"text"`
+	Link        string    `json:"link"`
+}
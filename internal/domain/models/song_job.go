@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SongJobPending   = "pending"
+	SongJobRunning   = "running"
+	SongJobSucceeded = "succeeded"
+	SongJobDead      = "dead"
+)
+
+// SongJob is a queued request to add a song with details fetched from Music Service.
+type SongJob struct {
+	ID          uuid.UUID  `json:"id"`
+	Group       string     `json:"group"`
+	Song        string     `json:"song"`
+	Status      string     `json:"status"`
+	Attempts    int32      `json:"attempts"`
+	MaxAttempts int32      `json:"max_attempts"`
+	Error       *string    `json:"error,omitempty"`
+	SongID      *uuid.UUID `json:"song_id,omitempty"`
+	RunTime     time.Time  `json:"run_time"`
+	CreatedTime time.Time  `json:"created_time"`
+	UpdatedTime time.Time  `json:"updated_time"`
+}
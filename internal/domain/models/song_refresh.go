@@ -0,0 +1,15 @@
+package models
+
+// SongRefresh is the outcome of comparing a song with the details fetched from the song
+// info providers. Applied is set if the changes were saved.
+type SongRefresh struct {
+	Song    Song          `json:"song"`
+	Changes []FieldChange `json:"changes"`
+	Applied bool          `json:"applied"`
+}
+
+type SongRefreshResult struct {
+	Refreshed int64
+	Updated   int64
+	Failed    int64
+}
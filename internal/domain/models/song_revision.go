@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+const (
+	SongRevisionCreate       = "create"
+	SongRevisionUpdate       = "update"
+	SongRevisionDelete       = "delete"
+	SongRevisionRestore      = "restore"
+	SongRevisionRevert       = "revert"
+	SongRevisionRefresh      = "refresh"
+	SongRevisionMove         = "move"
+	SongRevisionSyncedLyrics = "synced_lyrics"
+)
+
+type SongRevision struct {
+	Revision    int32     `json:"revision"`
+	Operation   string    `json:"operation"`
+	CreatedTime time.Time `json:"created_time"`
+	Song        Song      `json:"song"`
+}
+
+type SongDiff struct {
+	From   int32         `json:"from"`
+	To     int32         `json:"to"`
+	Fields []FieldChange `json:"fields"`
+	Verses []VerseChange `json:"verses"`
+}
+
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type VerseChange struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
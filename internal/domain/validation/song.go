@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"song-service/internal/domain/models"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hardfinhq/go-date"
+	"golang.org/x/text/language"
+)
+
+const (
+	FieldName        = "song"
+	FieldGroup       = "group"
+	FieldReleaseDate = "release_date"
+	FieldText        = "text"
+	FieldLink        = "link"
+	FieldLyrics      = "lyrics"
+	FieldLanguage    = "language"
+)
+
+// MaxNameLength is the length of the song and group name columns.
+const MaxNameLength = 255
+
+// MaxTextLength bounds the song text and translations, the structure detection compares
+// every pair of stanzas word by word.
+const MaxTextLength = 50000
+
+// ValidateSong checks every field of a new or replaced song. Text and link are required,
+// the language may be empty, a set language has to be a BCP 47 tag.
+func ValidateSong(song models.Song) error {
+	var v validator
+
+	v.name(FieldName, song.Name)
+	v.name(FieldGroup, song.Group)
+	v.releaseDate(song.ReleaseDate)
+	v.text(song.Text)
+
+	if song.Link == "" {
+		v.add(FieldLink, CodeRequired, "must not be empty")
+	} else {
+		v.link(song.Link)
+	}
+
+	if song.Language != "" {
+		v.language(song.Language)
+	}
+
+	return v.err()
+}
+
+// ValidateSongPatch checks a stored song after some of its fields changed, e.g. by a merge
+// patch. Unlike ValidateSong, it allows the text and link to be cleared.
+func ValidateSongPatch(song models.Song) error {
+	var v validator
+
+	v.name(FieldName, song.Name)
+	v.name(FieldGroup, song.Group)
+	v.releaseDate(song.ReleaseDate)
+	v.textLength(song.Text)
+
+	if song.Link != "" {
+		v.link(song.Link)
+	}
+
+	if song.Language != "" {
+		v.language(song.Language)
+	}
+
+	return v.err()
+}
+
+// ValidateSongName checks the song and group names, e.g. of a song whose other details
+// are fetched later from the song info providers.
+func ValidateSongName(name string, group string) error {
+	var v validator
+
+	v.name(FieldName, name)
+	v.name(FieldGroup, group)
+
+	return v.err()
+}
+
+// ValidateSongInfo checks song details fetched from a song info provider. Providers may
+// leave fields empty for other providers to fill, so only the fields set are checked.
+func ValidateSongInfo(songInfo models.SongInfo) error {
+	var v validator
+
+	if !songInfo.ReleaseDate.IsZero() {
+		v.releaseDate(songInfo.ReleaseDate)
+	}
+
+	if songInfo.Link != "" {
+		v.link(songInfo.Link)
+	}
+
+	return v.err()
+}
+
+// ValidateTranslation checks a translation of the song text before it is saved. The
+// language has to be a BCP 47 tag of a specific language and the text must not be empty.
+func ValidateTranslation(translation models.SongTranslation) error {
+	var v validator
+
+	v.language(translation.Language)
+
+	v.text(translation.Text)
+
+	return v.err()
+}
+
+func (v *validator) name(field string, name string) {
+	switch {
+	case strings.TrimSpace(name) == "":
+		v.add(field, CodeRequired, "must not be empty")
+	case utf8.RuneCountInString(name) > MaxNameLength:
+		v.add(field, CodeTooLong, fmt.Sprintf("must be at most %d characters long", MaxNameLength))
+	}
+}
+
+// releaseDate allows dates up to a day ahead of UTC, the release day may already
+// have started in the time zone of the client.
+func (v *validator) releaseDate(releaseDate date.Date) {
+	switch {
+	case releaseDate.IsZero():
+		v.add(FieldReleaseDate, CodeRequired, "must not be empty")
+	case releaseDate.After(date.Today().AddDays(1)):
+		v.add(FieldReleaseDate, CodeFutureDate, "must not be in the future")
+	}
+}
+
+func (v *validator) text(text string) {
+	if strings.TrimSpace(text) == "" {
+		v.add(FieldText, CodeRequired, "must not be empty")
+		return
+	}
+
+	v.textLength(text)
+}
+
+func (v *validator) textLength(text string) {
+	if utf8.RuneCountInString(text) > MaxTextLength {
+		v.add(FieldText, CodeTooLong, fmt.Sprintf("must be at most %d characters long", MaxTextLength))
+	}
+}
+
+func (v *validator) language(tag string) {
+	if parsed, err := language.Parse(tag); err != nil || parsed == language.Und {
+		v.add(FieldLanguage, CodeInvalidLanguage, "must be a BCP 47 language tag")
+	}
+}
+
+func (v *validator) link(link string) {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(FieldLink, CodeInvalidURL, "must be an absolute http or https URL")
+	}
+}
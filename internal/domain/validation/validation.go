@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	CodeRequired     = "required"
+	CodeTooLong      = "too_long"
+	CodeInvalidURL   = "invalid_url"
+	CodeFutureDate   = "future_date"
+	CodeInvalidType  = "invalid_type"
+	CodeUnknownField = "unknown_field"
+
+	CodeMissingTimestamp = "missing_timestamp"
+	CodeInvalidTimestamp = "invalid_timestamp"
+	CodeInvalidTag       = "invalid_tag"
+
+	CodeInvalidLanguage = "invalid_language"
+)
+
+// FieldError is a single violated rule of a field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error lists every field violation found in a value.
+type Error struct {
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	violations := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		violations = append(violations, fmt.Sprintf("%s %s", field.Field, field.Message))
+	}
+
+	return fmt.Sprintf("validation failed: %s", strings.Join(violations, ", "))
+}
+
+// validator collects field violations so that all of them are reported at once.
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field string, code string, message string) {
+	v.fields = append(v.fields, FieldError{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	})
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return &Error{
+		Fields: v.fields,
+	}
+}
@@ -43,6 +43,20 @@ func (m TransactionManager) WithTransaction(ctx context.Context, f func(ctx cont
 		AccessMode: pgx.ReadWrite,
 	}
 
+	return m.withTransaction(ctx, txOptions, f)
+}
+
+// WithSnapshot runs f in a read only transaction where all queries see the same snapshot of the data.
+func (m TransactionManager) WithSnapshot(ctx context.Context, f func(ctx context.Context) error) error {
+	txOptions := pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}
+
+	return m.withTransaction(ctx, txOptions, f)
+}
+
+func (m TransactionManager) withTransaction(ctx context.Context, txOptions pgx.TxOptions, f func(ctx context.Context) error) error {
 	if _, ok := ctx.Value(txKeyValue).(Transaction); ok {
 		return f(ctx)
 	}
@@ -0,0 +1,90 @@
+package pgrepo
+
+import (
+	"context"
+	"log/slog"
+	"song-service/internal/infrastructure/database/postgres"
+	"song-service/internal/infrastructure/repository/queries"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CacheRepository is a persistent key-value cache with per-entry TTL.
+type CacheRepository struct {
+	txManager postgres.TransactionManager
+	logger    *slog.Logger
+	tracer    trace.Tracer
+}
+
+func NewCacheRepository(txManager postgres.TransactionManager, logger *slog.Logger, tracer trace.Tracer) *CacheRepository {
+	return &CacheRepository{
+		txManager: txManager,
+		logger:    logger,
+		tracer:    tracer,
+	}
+}
+
+func (r *CacheRepository) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	ctx, span := r.tracer.Start(ctx, "CacheRepository.Get")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	value, err := querier.GetCacheEntry(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (r *CacheRepository) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ctx, span := r.tracer.Start(ctx, "CacheRepository.Set")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.SetCacheEntryParams{
+		Key:       key,
+		Value:     value,
+		ExpiresIn: pgtype.Interval{Microseconds: ttl.Microseconds(), Valid: true},
+	}
+
+	if err := querier.SetCacheEntry(ctx, args); err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	return nil
+}
+
+// Purge deletes expired entries.
+func (r *CacheRepository) Purge(ctx context.Context) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "CacheRepository.Purge")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	count, err := querier.PurgeCacheEntries(ctx)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return 0, err
+	}
+
+	return count, nil
+}
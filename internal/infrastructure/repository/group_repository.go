@@ -0,0 +1,394 @@
+package pgrepo
+
+import (
+	"context"
+	"log/slog"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+	"song-service/internal/infrastructure/database/postgres"
+	"song-service/internal/infrastructure/repository/queries"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GroupRepository struct {
+	txManager postgres.TransactionManager
+	logger    *slog.Logger
+	tracer    trace.Tracer
+}
+
+func NewGroupRepository(txManager postgres.TransactionManager, logger *slog.Logger, tracer trace.Tracer) *GroupRepository {
+	return &GroupRepository{
+		txManager: txManager,
+		logger:    logger,
+		tracer:    tracer,
+	}
+}
+
+func (r *GroupRepository) Create(ctx context.Context, group models.Group) (models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Create")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	groupID, err := querier.InsertGroup(ctx, group.Name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, errors.Wrapf(repo.ErrDuplicate, "group with name = %s already exists", group.Name)
+		}
+
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.Group{}, err
+	}
+
+	group.ID = groupID
+	group.SongCount = 0
+
+	return group, nil
+}
+
+func (r *GroupRepository) GetByID(ctx context.Context, id uuid.UUID) (models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.GetByID")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	row, err := querier.GetGroupByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, errors.Wrapf(repo.ErrObjectNotFound, "group with id = %s not found", id.String())
+		}
+
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.Group{}, err
+	}
+
+	group := models.Group{
+		ID:        row.Group.ID,
+		Name:      row.Group.Name,
+		SongCount: row.SongCount,
+	}
+
+	return group, nil
+}
+
+func (r *GroupRepository) GetByName(ctx context.Context, name string) (models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.GetByName")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	row, err := querier.GetGroupByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, errors.Wrapf(repo.ErrObjectNotFound, "group with name = %s not found", name)
+		}
+
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.Group{}, err
+	}
+
+	group := models.Group{
+		ID:        row.Group.ID,
+		Name:      row.Group.Name,
+		SongCount: row.SongCount,
+	}
+
+	return group, nil
+}
+
+// Lock locks the active group with the id and the active group with the name and returns
+// them. The rows are locked in id order, so that concurrent renames of two groups into
+// each other can't deadlock.
+func (r *GroupRepository) Lock(ctx context.Context, id uuid.UUID, name string) ([]models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Lock")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.LockGroupsParams{
+		ID:   id,
+		Name: name,
+	}
+
+	rows, err := querier.LockGroups(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	groupList := make([]models.Group, 0, len(rows))
+	for _, row := range rows {
+		group := models.Group{
+			ID:        row.Group.ID,
+			Name:      row.Group.Name,
+			SongCount: row.SongCount,
+		}
+
+		groupList = append(groupList, group)
+	}
+
+	return groupList, nil
+}
+
+func (r *GroupRepository) List(ctx context.Context, filter *repo.GroupFilter, pagination *repo.Pagination) ([]models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.List")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	var args queries.ListGroupParams
+
+	if filter != nil {
+		args.Name = filter.Name
+	}
+
+	if pagination != nil {
+		if pagination.Limit > 0 {
+			args.Limit = &pagination.Limit
+		}
+
+		args.Offset = pagination.Offset
+	}
+
+	rows, err := querier.ListGroup(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	groupList := make([]models.Group, 0, len(rows))
+	for _, row := range rows {
+		group := models.Group{
+			ID:        row.Group.ID,
+			Name:      row.Group.Name,
+			SongCount: row.SongCount,
+		}
+
+		groupList = append(groupList, group)
+	}
+
+	return groupList, nil
+}
+
+func (r *GroupRepository) Update(ctx context.Context, group models.Group) (models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Update")
+	defer span.End()
+
+	var updatedGroup models.Group
+
+	if err := r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := r.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		if err := querier.RenameGroup(ctx, queries.RenameGroupParams{
+			ID:   group.ID,
+			Name: group.Name,
+		}); err != nil {
+			r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			var pgErr *pgconn.PgError
+
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == pgerrcode.UniqueViolation {
+					return errors.Wrapf(repo.ErrDuplicate, "group with name = %s already exists", group.Name)
+				}
+			}
+
+			return err
+		}
+
+		if err := r.incrementSongVersions(ctx, querier, group.ID); err != nil {
+			return err
+		}
+
+		var err error
+
+		updatedGroup, err = r.GetByID(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return models.Group{}, err
+	}
+
+	return updatedGroup, nil
+}
+
+// MoveSongs moves every song of a group to another group, recording a revision for each of
+// the moved songs.
+func (r *GroupRepository) MoveSongs(ctx context.Context, fromID uuid.UUID, toID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.MoveSongs")
+	defer span.End()
+
+	return r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := r.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		songIDs, err := querier.MoveGroupSongs(ctx, queries.MoveGroupSongsParams{
+			FromGroupID: fromID,
+			ToGroupID:   toID,
+		})
+		if err != nil {
+			r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			var pgErr *pgconn.PgError
+
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == pgerrcode.UniqueViolation {
+					return errors.Wrapf(repo.ErrDuplicate, "group with id = %s already has songs with the same names", toID.String())
+				}
+			}
+
+			return err
+		}
+
+		if len(songIDs) == 0 {
+			return nil
+		}
+
+		if err := querier.CreateSongRevisions(ctx, queries.CreateSongRevisionsParams{
+			Operation: models.SongRevisionMove,
+			SongIds:   songIDs,
+		}); err != nil {
+			r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *GroupRepository) Delete(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Delete")
+	defer span.End()
+
+	var deletedAt *time.Time
+
+	if err := r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := r.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		var err error
+
+		deletedAt, err = querier.DeleteGroup(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errors.Wrapf(repo.ErrObjectNotFound, "group with id = %s not found", id.String())
+			}
+
+			r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return r.incrementSongVersions(ctx, querier, id)
+	}); err != nil {
+		return nil, err
+	}
+
+	return deletedAt, nil
+}
+
+func (r *GroupRepository) Restore(ctx context.Context, id uuid.UUID) (models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Restore")
+	defer span.End()
+
+	var restoredGroup models.Group
+
+	if err := r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := r.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		if _, err := querier.RestoreGroup(ctx, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errors.Wrapf(repo.ErrObjectNotFound, "group with id = %s not found", id.String())
+			}
+
+			r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			var pgErr *pgconn.PgError
+
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == pgerrcode.UniqueViolation {
+					return errors.Wrapf(repo.ErrDuplicate, "active group with the same name as group with id = %s already exists", id.String())
+				}
+			}
+
+			return err
+		}
+
+		if err := r.incrementSongVersions(ctx, querier, id); err != nil {
+			return err
+		}
+
+		var err error
+
+		restoredGroup, err = r.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return models.Group{}, err
+	}
+
+	return restoredGroup, nil
+}
+
+// Purge hard deletes deleted groups without songs that were deleted longer than retention
+// ago or are listed in groupIDs. Active groups are kept even if they have no songs.
+func (r *GroupRepository) Purge(ctx context.Context, retention time.Duration, groupIDs []uuid.UUID) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "GroupRepository.Purge")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.PurgeGroupsParams{
+		Retention: pgtype.Interval{Microseconds: retention.Microseconds(), Valid: true},
+		GroupIds:  groupIDs,
+	}
+
+	count, err := querier.PurgeGroups(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// incrementSongVersions bumps the version of every song of the group, the group is part of
+// the song representation, so that cached songs become stale when the group changes.
+func (r *GroupRepository) incrementSongVersions(ctx context.Context, querier *queries.Queries, id uuid.UUID) error {
+	if err := querier.IncrementGroupSongVersions(ctx, id); err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	return nil
+}
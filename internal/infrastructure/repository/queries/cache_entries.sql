@@ -0,0 +1,35 @@
+-- cache_entries.sql
+
+-- name: GetCacheEntry :one
+SELECT
+    value
+FROM
+    cache_entries
+WHERE
+    key = $1
+    AND expires_at > NOW();
+
+
+-- name: SetCacheEntry :exec
+INSERT INTO cache_entries (
+    key,
+    value,
+    expires_at
+)
+VALUES (
+    $1,
+    $2,
+    NOW() + sqlc.arg('expires_in')::INTERVAL
+)
+ON CONFLICT (key)
+DO UPDATE
+SET
+    value = EXCLUDED.value,
+    expires_at = EXCLUDED.expires_at;
+
+
+-- name: PurgeCacheEntries :execrows
+DELETE FROM
+    cache_entries
+WHERE
+    expires_at <= NOW();
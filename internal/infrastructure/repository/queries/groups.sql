@@ -4,7 +4,139 @@
 -- name: CreateGroup :one
 INSERT INTO groups (name)
 VALUES ($1)
-ON CONFLICT (name) 
+ON CONFLICT (name) WHERE deleted_at IS NULL
 DO UPDATE 
   SET name = EXCLUDED.name
 RETURNING id;
+
+
+-- name: InsertGroup :one
+INSERT INTO groups (name)
+VALUES ($1)
+ON CONFLICT (name) WHERE deleted_at IS NULL
+DO NOTHING
+RETURNING id;
+
+
+-- name: RenameGroup :exec
+UPDATE
+    groups
+SET
+    name = $2
+WHERE
+    id = $1;
+
+
+-- name: DeleteGroup :one
+UPDATE
+    groups
+SET
+    deleted_at = COALESCE(deleted_at, NOW())
+WHERE
+    id = $1
+RETURNING
+    deleted_at;
+
+
+-- name: RestoreGroup :one
+UPDATE
+    groups
+SET
+    deleted_at = NULL
+WHERE
+    id = $1
+RETURNING
+    id;
+
+
+-- name: MoveGroupSongs :many
+UPDATE
+    songs
+SET
+    group_id = sqlc.arg('to_group_id'),
+    version = version + 1
+WHERE
+    group_id = sqlc.arg('from_group_id')
+RETURNING
+    id;
+
+
+-- name: IncrementGroupSongVersions :exec
+UPDATE
+    songs
+SET
+    version = version + 1
+WHERE
+    group_id = $1;
+
+
+-- name: GetGroupByID :one
+SELECT
+    sqlc.embed(g),
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.id = $1
+    AND g.deleted_at IS NULL;
+
+
+-- name: GetGroupByName :one
+SELECT
+    sqlc.embed(g),
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.name = $1
+    AND g.deleted_at IS NULL;
+
+
+-- name: ListGroup :many
+SELECT
+    sqlc.embed(g),
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.deleted_at IS NULL
+    AND (sqlc.narg('name')::VARCHAR(255)[] IS NULL OR g.name = ANY(sqlc.narg('name')::VARCHAR(255)[]))
+ORDER BY
+    g.name
+LIMIT 
+    sqlc.narg('limit')
+OFFSET 
+    sqlc.arg('offset');
+
+
+-- name: LockGroups :many
+SELECT
+    sqlc.embed(g),
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    (g.id = sqlc.arg('id') OR g.name = sqlc.arg('name'))
+    AND g.deleted_at IS NULL
+ORDER BY
+    g.id
+FOR UPDATE OF g;
+
+
+-- name: PurgeGroups :execrows
+DELETE FROM
+    groups g
+WHERE
+    (
+        g.deleted_at < NOW() - sqlc.arg('retention')::INTERVAL
+        OR (g.deleted_at IS NOT NULL AND g.id = ANY(sqlc.arg('group_ids')::UUID[]))
+    )
+    AND NOT EXISTS (SELECT 1 FROM songs s WHERE s.group_id = g.id);
@@ -7,8 +7,10 @@ package queries
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createGroup = `-- name: CreateGroup :one
@@ -16,7 +18,7 @@ const createGroup = `-- name: CreateGroup :one
 
 INSERT INTO groups (name)
 VALUES ($1)
-ON CONFLICT (name) 
+ON CONFLICT (name) WHERE deleted_at IS NULL
 DO UPDATE 
   SET name = EXCLUDED.name
 RETURNING id
@@ -29,3 +31,317 @@ func (q *Queries) CreateGroup(ctx context.Context, name string) (uuid.UUID, erro
 	err := row.Scan(&id)
 	return id, err
 }
+
+const deleteGroup = `-- name: DeleteGroup :one
+UPDATE
+    groups
+SET
+    deleted_at = COALESCE(deleted_at, NOW())
+WHERE
+    id = $1
+RETURNING
+    deleted_at
+`
+
+func (q *Queries) DeleteGroup(ctx context.Context, id uuid.UUID) (*time.Time, error) {
+	row := q.db.QueryRow(ctx, deleteGroup, id)
+	var deleted_at *time.Time
+	err := row.Scan(&deleted_at)
+	return deleted_at, err
+}
+
+const getGroupByID = `-- name: GetGroupByID :one
+SELECT
+    g.id, g.name, g.deleted_at, g.search_vector,
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.id = $1
+    AND g.deleted_at IS NULL
+`
+
+type GetGroupByIDRow struct {
+	Group     Group
+	SongCount int64
+}
+
+func (q *Queries) GetGroupByID(ctx context.Context, id uuid.UUID) (GetGroupByIDRow, error) {
+	row := q.db.QueryRow(ctx, getGroupByID, id)
+	var i GetGroupByIDRow
+	err := row.Scan(
+		&i.Group.ID,
+		&i.Group.Name,
+		&i.Group.DeletedAt,
+		&i.Group.SearchVector,
+		&i.SongCount,
+	)
+	return i, err
+}
+
+const getGroupByName = `-- name: GetGroupByName :one
+SELECT
+    g.id, g.name, g.deleted_at, g.search_vector,
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.name = $1
+    AND g.deleted_at IS NULL
+`
+
+type GetGroupByNameRow struct {
+	Group     Group
+	SongCount int64
+}
+
+func (q *Queries) GetGroupByName(ctx context.Context, name string) (GetGroupByNameRow, error) {
+	row := q.db.QueryRow(ctx, getGroupByName, name)
+	var i GetGroupByNameRow
+	err := row.Scan(
+		&i.Group.ID,
+		&i.Group.Name,
+		&i.Group.DeletedAt,
+		&i.Group.SearchVector,
+		&i.SongCount,
+	)
+	return i, err
+}
+
+const incrementGroupSongVersions = `-- name: IncrementGroupSongVersions :exec
+UPDATE
+    songs
+SET
+    version = version + 1
+WHERE
+    group_id = $1
+`
+
+func (q *Queries) IncrementGroupSongVersions(ctx context.Context, groupID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, incrementGroupSongVersions, groupID)
+	return err
+}
+
+const insertGroup = `-- name: InsertGroup :one
+INSERT INTO groups (name)
+VALUES ($1)
+ON CONFLICT (name) WHERE deleted_at IS NULL
+DO NOTHING
+RETURNING id
+`
+
+func (q *Queries) InsertGroup(ctx context.Context, name string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertGroup, name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const listGroup = `-- name: ListGroup :many
+SELECT
+    g.id, g.name, g.deleted_at, g.search_vector,
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    g.deleted_at IS NULL
+    AND ($1::VARCHAR(255)[] IS NULL OR g.name = ANY($1::VARCHAR(255)[]))
+ORDER BY
+    g.name
+LIMIT 
+    $3
+OFFSET 
+    $2
+`
+
+type ListGroupParams struct {
+	Name   []string
+	Offset int32
+	Limit  *int32
+}
+
+type ListGroupRow struct {
+	Group     Group
+	SongCount int64
+}
+
+func (q *Queries) ListGroup(ctx context.Context, arg ListGroupParams) ([]ListGroupRow, error) {
+	rows, err := q.db.Query(ctx, listGroup, arg.Name, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGroupRow{}
+	for rows.Next() {
+		var i ListGroupRow
+		if err := rows.Scan(
+			&i.Group.ID,
+			&i.Group.Name,
+			&i.Group.DeletedAt,
+			&i.Group.SearchVector,
+			&i.SongCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockGroups = `-- name: LockGroups :many
+SELECT
+    g.id, g.name, g.deleted_at, g.search_vector,
+    (
+        SELECT COUNT(*) FROM songs s WHERE s.group_id = g.id AND s.deleted_at IS NULL
+    )::BIGINT AS song_count
+FROM 
+    groups g
+WHERE
+    (g.id = $1 OR g.name = $2)
+    AND g.deleted_at IS NULL
+ORDER BY
+    g.id
+FOR UPDATE OF g
+`
+
+type LockGroupsParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type LockGroupsRow struct {
+	Group     Group
+	SongCount int64
+}
+
+func (q *Queries) LockGroups(ctx context.Context, arg LockGroupsParams) ([]LockGroupsRow, error) {
+	rows, err := q.db.Query(ctx, lockGroups, arg.ID, arg.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LockGroupsRow{}
+	for rows.Next() {
+		var i LockGroupsRow
+		if err := rows.Scan(
+			&i.Group.ID,
+			&i.Group.Name,
+			&i.Group.DeletedAt,
+			&i.Group.SearchVector,
+			&i.SongCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveGroupSongs = `-- name: MoveGroupSongs :many
+UPDATE
+    songs
+SET
+    group_id = $1,
+    version = version + 1
+WHERE
+    group_id = $2
+RETURNING
+    id
+`
+
+type MoveGroupSongsParams struct {
+	ToGroupID   uuid.UUID
+	FromGroupID uuid.UUID
+}
+
+func (q *Queries) MoveGroupSongs(ctx context.Context, arg MoveGroupSongsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, moveGroupSongs, arg.ToGroupID, arg.FromGroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeGroups = `-- name: PurgeGroups :execrows
+DELETE FROM
+    groups g
+WHERE
+    (
+        g.deleted_at < NOW() - $1::INTERVAL
+        OR (g.deleted_at IS NOT NULL AND g.id = ANY($2::UUID[]))
+    )
+    AND NOT EXISTS (SELECT 1 FROM songs s WHERE s.group_id = g.id)
+`
+
+type PurgeGroupsParams struct {
+	Retention pgtype.Interval
+	GroupIds  []uuid.UUID
+}
+
+func (q *Queries) PurgeGroups(ctx context.Context, arg PurgeGroupsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeGroups, arg.Retention, arg.GroupIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renameGroup = `-- name: RenameGroup :exec
+UPDATE
+    groups
+SET
+    name = $2
+WHERE
+    id = $1
+`
+
+type RenameGroupParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+func (q *Queries) RenameGroup(ctx context.Context, arg RenameGroupParams) error {
+	_, err := q.db.Exec(ctx, renameGroup, arg.ID, arg.Name)
+	return err
+}
+
+const restoreGroup = `-- name: RestoreGroup :one
+UPDATE
+    groups
+SET
+    deleted_at = NULL
+WHERE
+    id = $1
+RETURNING
+    id
+`
+
+func (q *Queries) RestoreGroup(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, restoreGroup, id)
+	err := row.Scan(&id)
+	return id, err
+}
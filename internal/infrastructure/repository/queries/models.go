@@ -11,18 +11,73 @@ import (
 	date "github.com/hardfinhq/go-date"
 )
 
+type CacheEntry struct {
+	Key       string
+	Value     []byte
+	ExpiresAt time.Time
+}
+
 type Group struct {
-	ID        uuid.UUID
-	Name      string
-	DeletedAt *time.Time
+	ID           uuid.UUID
+	Name         string
+	DeletedAt    *time.Time
+	SearchVector interface{}
 }
 
 type Song struct {
+	ID                uuid.UUID
+	Name              string
+	GroupID           uuid.UUID
+	ReleaseDate       date.Date
+	Text              string
+	Link              string
+	DeletedAt         *time.Time
+	SearchVector      interface{}
+	Version           int32
+	RefreshedAt       time.Time
+	Structure         []byte
+	Language          string
+	GroupSearchVector interface{}
+}
+
+type SongJob struct {
 	ID          uuid.UUID
+	GroupName   string
 	Name        string
-	GroupID     uuid.UUID
+	Status      string
+	Attempts    int32
+	MaxAttempts int32
+	Error       *string
+	SongID      *uuid.UUID
+	RunAt       time.Time
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SongLyric struct {
+	SongID    uuid.UUID
+	Language  string
+	Text      string
+	Structure []byte
+	UpdatedAt time.Time
+}
+
+type SongRevision struct {
+	SongID      uuid.UUID
+	Revision    int32
+	Operation   string
+	Name        string
+	GroupName   string
 	ReleaseDate date.Date
 	Text        string
 	Link        string
-	DeletedAt   *time.Time
+	CreatedAt   time.Time
+	Language    string
+}
+
+type SongSyncedLyric struct {
+	SongID    uuid.UUID
+	Lyrics    []byte
+	UpdatedAt time.Time
 }
@@ -0,0 +1,110 @@
+-- song_jobs.sql
+
+-- name: CreateSongJob :one
+INSERT INTO song_jobs (
+    group_name,
+    name,
+    max_attempts
+)
+VALUES (
+    $1,
+    $2,
+    $3
+)
+RETURNING
+    *;
+
+
+-- name: GetSongJobByID :one
+SELECT
+    *
+FROM
+    song_jobs
+WHERE
+    id = $1;
+
+
+-- name: ClaimSongJobs :many
+WITH claimed AS (
+    SELECT
+        id
+    FROM
+        song_jobs
+    WHERE
+        (status = 'pending' AND run_at <= NOW())
+        OR (status = 'running' AND locked_until < NOW() AND attempts < max_attempts)
+    ORDER BY
+        run_at
+    LIMIT
+        sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    song_jobs
+SET
+    status = 'running',
+    attempts = attempts + 1,
+    locked_until = NOW() + sqlc.arg('lease')::INTERVAL,
+    updated_at = NOW()
+WHERE
+    id IN (SELECT id FROM claimed)
+RETURNING
+    *;
+
+
+-- name: FailExpiredSongJobs :execrows
+UPDATE
+    song_jobs
+SET
+    status = 'dead',
+    error = 'job lease expired on the last attempt',
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE
+    status = 'running'
+    AND locked_until < NOW()
+    AND attempts >= max_attempts;
+
+
+-- name: CompleteSongJob :execrows
+UPDATE
+    song_jobs
+SET
+    status = 'succeeded',
+    song_id = $3,
+    error = NULL,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE
+    id = $1
+    AND attempts = $2
+    AND status = 'running';
+
+
+-- name: RetrySongJob :execrows
+UPDATE
+    song_jobs
+SET
+    status = 'pending',
+    error = $3,
+    run_at = NOW() + sqlc.arg('backoff')::INTERVAL,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE
+    id = $1
+    AND attempts = $2
+    AND status = 'running';
+
+
+-- name: FailSongJob :execrows
+UPDATE
+    song_jobs
+SET
+    status = 'dead',
+    error = $3,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE
+    id = $1
+    AND attempts = $2
+    AND status = 'running';
@@ -0,0 +1,51 @@
+-- song_lyrics.sql
+
+-- name: UpsertSongLyrics :one
+INSERT INTO song_lyrics (
+    song_id,
+    language,
+    text,
+    structure
+)
+VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (song_id, language)
+DO UPDATE
+SET
+    text = EXCLUDED.text,
+    structure = EXCLUDED.structure,
+    updated_at = NOW()
+RETURNING
+    *;
+
+
+-- name: GetSongLyrics :one
+SELECT
+    *
+FROM
+    song_lyrics
+WHERE
+    song_id = $1
+    AND language = $2;
+
+
+-- name: ListSongLyrics :many
+SELECT
+    *
+FROM
+    song_lyrics
+WHERE
+    song_id = $1
+ORDER BY
+    language;
+
+
+-- name: DeleteSongLyrics :one
+DELETE FROM
+    song_lyrics
+WHERE
+    song_id = $1
+    AND language = $2
+RETURNING
+    *;
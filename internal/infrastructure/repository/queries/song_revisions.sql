@@ -0,0 +1,87 @@
+-- song_revisions.sql
+
+-- name: CreateSongRevision :one
+INSERT INTO song_revisions (
+    song_id,
+    revision,
+    operation,
+    name,
+    group_name,
+    release_date,
+    text,
+    link,
+    language
+)
+SELECT
+    s.id,
+    (
+        SELECT COALESCE(MAX(r.revision), 0) + 1 FROM song_revisions r WHERE r.song_id = s.id
+    )::INTEGER,
+    sqlc.arg('operation')::VARCHAR(16),
+    s.name,
+    g.name,
+    s.release_date,
+    s.text,
+    s.link,
+    s.language
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    s.id = sqlc.arg('song_id')
+RETURNING
+    revision;
+
+
+-- name: CreateSongRevisions :exec
+INSERT INTO song_revisions (
+    song_id,
+    revision,
+    operation,
+    name,
+    group_name,
+    release_date,
+    text,
+    link,
+    language
+)
+SELECT
+    s.id,
+    (
+        SELECT COALESCE(MAX(r.revision), 0) + 1 FROM song_revisions r WHERE r.song_id = s.id
+    )::INTEGER,
+    sqlc.arg('operation')::VARCHAR(16),
+    s.name,
+    g.name,
+    s.release_date,
+    s.text,
+    s.link,
+    s.language
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    s.id = ANY(sqlc.arg('song_ids')::UUID[]);
+
+
+-- name: GetSongRevision :one
+SELECT
+    *
+FROM
+    song_revisions
+WHERE
+    song_id = $1
+    AND revision = $2;
+
+
+-- name: ListSongRevision :many
+SELECT
+    *
+FROM
+    song_revisions
+WHERE
+    song_id = $1
+ORDER BY
+    revision;
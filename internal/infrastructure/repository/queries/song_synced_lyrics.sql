@@ -0,0 +1,37 @@
+-- song_synced_lyrics.sql
+
+-- name: UpsertSongSyncedLyrics :exec
+INSERT INTO song_synced_lyrics (
+    song_id,
+    lyrics
+)
+VALUES (
+    $1, $2
+)
+ON CONFLICT (song_id)
+DO UPDATE
+SET
+    lyrics = EXCLUDED.lyrics,
+    updated_at = NOW();
+
+
+-- name: GetSongSyncedLyrics :one
+SELECT
+    l.lyrics
+FROM
+    song_synced_lyrics l
+JOIN
+    songs s ON l.song_id = s.id
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    l.song_id = $1
+    AND s.deleted_at IS NULL
+    AND g.deleted_at IS NULL;
+
+
+-- name: DeleteSongSyncedLyrics :exec
+DELETE FROM
+    song_synced_lyrics
+WHERE
+    song_id = $1;
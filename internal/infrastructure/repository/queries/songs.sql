@@ -6,25 +6,30 @@ INSERT INTO songs (
     group_id,
     release_date,
     text,
-    link
+    link,
+    language,
+    structure
 )
 VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
-ON CONFLICT (name, group_id) 
+ON CONFLICT (name, group_id) WHERE deleted_at IS NULL
 DO UPDATE 
 SET
     release_date = EXCLUDED.release_date,
     text = EXCLUDED.text,
-    link = EXCLUDED.link
+    link = EXCLUDED.link,
+    language = EXCLUDED.language,
+    structure = EXCLUDED.structure
 WHERE 
     songs.release_date = EXCLUDED.release_date
     AND songs.text = EXCLUDED.text
     AND songs.link = EXCLUDED.link
-RETURNING id;
+    AND songs.language = EXCLUDED.language
+RETURNING id, version, (xmax = 0)::BOOLEAN AS inserted;
 
 
--- name: UpdateSong :exec
+-- name: UpdateSong :one
 UPDATE 
     songs 
 SET 
@@ -32,9 +37,25 @@ SET
   group_id = $3,
   release_date = $4,
   text = $5,
-  link = $6
+  link = $6,
+  language = $7,
+  structure = $8,
+  version = version + 1
 WHERE
-    id = $1;
+    id = $1
+RETURNING
+    version;
+
+
+-- name: IncrementSongVersion :one
+UPDATE
+    songs
+SET
+    version = version + 1
+WHERE
+    id = $1
+RETURNING
+    version;
 
 
 -- name: DeleteSong :one
@@ -62,7 +83,7 @@ WHERE
     AND g.deleted_at IS NULL;
 
 
--- name: ListSong :many
+-- name: GetSongByIDForUpdate :one
 SELECT
     sqlc.embed(s),
     sqlc.embed(g)
@@ -71,15 +92,171 @@ FROM
 JOIN
     groups g ON s.group_id = g.id
 WHERE
-    s.deleted_at IS NULL
+    s.id = $1
+    AND s.deleted_at IS NULL
+    AND g.deleted_at IS NULL
+FOR UPDATE OF s;
+
+
+-- name: RestoreSong :one
+UPDATE
+    songs s
+SET
+    deleted_at = NULL
+FROM
+    groups g
+WHERE
+    s.id = $1
+    AND s.group_id = g.id
+    AND g.deleted_at IS NULL
+RETURNING
+    s.id;
+
+
+-- name: ListDeletedSong :many
+SELECT
+    sqlc.embed(s),
+    sqlc.embed(g)
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    s.deleted_at IS NOT NULL
     AND g.deleted_at IS NULL
-    AND (sqlc.narg('name')::VARCHAR(255)[] IS NULL OR s.name = ANY(sqlc.narg('name')::VARCHAR(255)[]))
-    AND (sqlc.narg('group')::VARCHAR(255)[] IS NULL OR g.name = ANY(sqlc.narg('group')::VARCHAR(255)[]))
-    AND (sqlc.narg('release_date_from')::DATE IS NULL OR s.release_date >= sqlc.narg('release_date_from')::DATE)
-    AND (sqlc.narg('release_date_to')::DATE IS NULL OR s.release_date <= sqlc.narg('release_date_to')::DATE)
-    AND (sqlc.narg('text')::TEXT[] IS NULL OR s.text = ANY(sqlc.narg('text')::TEXT[]))
-    AND (sqlc.narg('link')::TEXT[] IS NULL OR s.link = ANY(sqlc.narg('link')::TEXT[]))
-LIMIT 
+ORDER BY
+    s.deleted_at DESC,
+    s.id
+LIMIT
     sqlc.narg('limit')
-OFFSET 
+OFFSET
     sqlc.arg('offset');
+
+
+-- name: PurgeSongs :many
+WITH expired AS (
+    SELECT
+        s.id
+    FROM
+        songs s
+    JOIN
+        groups g ON s.group_id = g.id
+    WHERE
+        s.deleted_at < NOW() - sqlc.arg('retention')::INTERVAL
+        OR g.deleted_at < NOW() - sqlc.arg('retention')::INTERVAL
+    LIMIT
+        sqlc.arg('batch_size')
+    FOR UPDATE OF s SKIP LOCKED
+)
+DELETE FROM
+    songs
+WHERE
+    id IN (SELECT id FROM expired)
+RETURNING
+    group_id;
+
+
+-- name: CreateSongBatch :batchone
+WITH new_group AS (
+    INSERT INTO groups (name)
+    VALUES (sqlc.arg('group_name')::VARCHAR(255))
+    ON CONFLICT (name) WHERE deleted_at IS NULL
+    DO UPDATE
+      SET name = EXCLUDED.name
+    RETURNING id
+), new_song AS (
+    INSERT INTO songs (
+        name,
+        group_id,
+        release_date,
+        text,
+        link,
+        language,
+        structure
+    )
+    SELECT
+        sqlc.arg('name')::VARCHAR(255),
+        new_group.id,
+        sqlc.arg('release_date')::DATE,
+        sqlc.arg('text')::TEXT,
+        sqlc.arg('link')::TEXT,
+        sqlc.arg('language')::VARCHAR(35),
+        sqlc.arg('structure')::JSONB
+    FROM
+        new_group
+    ON CONFLICT (name, group_id) WHERE deleted_at IS NULL
+    DO UPDATE
+    SET
+        release_date = EXCLUDED.release_date,
+        text = EXCLUDED.text,
+        link = EXCLUDED.link,
+        language = EXCLUDED.language,
+        structure = EXCLUDED.structure
+    WHERE
+        songs.release_date = EXCLUDED.release_date
+        AND songs.text = EXCLUDED.text
+        AND songs.link = EXCLUDED.link
+        AND songs.language = EXCLUDED.language
+    RETURNING id, version, (xmax = 0)::BOOLEAN AS inserted
+), new_revision AS (
+    INSERT INTO song_revisions (
+        song_id,
+        revision,
+        operation,
+        name,
+        group_name,
+        release_date,
+        text,
+        link,
+        language
+    )
+    SELECT
+        new_song.id,
+        1,
+        'create',
+        sqlc.arg('name')::VARCHAR(255),
+        sqlc.arg('group_name')::VARCHAR(255),
+        sqlc.arg('release_date')::DATE,
+        sqlc.arg('text')::TEXT,
+        sqlc.arg('link')::TEXT,
+        sqlc.arg('language')::VARCHAR(35)
+    FROM
+        new_song
+    WHERE
+        new_song.inserted
+)
+SELECT
+    id,
+    version,
+    inserted
+FROM
+    new_song;
+
+
+
+-- name: MarkSongRefreshed :exec
+UPDATE
+    songs
+SET
+    refreshed_at = NOW()
+WHERE
+    id = $1;
+
+
+-- name: ListStaleSongs :many
+SELECT
+    sqlc.embed(s),
+    sqlc.embed(g)
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    s.deleted_at IS NULL
+    AND g.deleted_at IS NULL
+    AND s.refreshed_at < NOW() - sqlc.arg('max_age')::INTERVAL
+ORDER BY
+    s.refreshed_at,
+    s.id
+LIMIT
+    sqlc.arg('batch_size');
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	date "github.com/hardfinhq/go-date"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createSong = `-- name: CreateSong :one
@@ -20,22 +21,27 @@ INSERT INTO songs (
     group_id,
     release_date,
     text,
-    link
+    link,
+    language,
+    structure
 )
 VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
-ON CONFLICT (name, group_id) 
+ON CONFLICT (name, group_id) WHERE deleted_at IS NULL
 DO UPDATE 
 SET
     release_date = EXCLUDED.release_date,
     text = EXCLUDED.text,
-    link = EXCLUDED.link
+    link = EXCLUDED.link,
+    language = EXCLUDED.language,
+    structure = EXCLUDED.structure
 WHERE 
     songs.release_date = EXCLUDED.release_date
     AND songs.text = EXCLUDED.text
     AND songs.link = EXCLUDED.link
-RETURNING id
+    AND songs.language = EXCLUDED.language
+RETURNING id, version, (xmax = 0)::BOOLEAN AS inserted
 `
 
 type CreateSongParams struct {
@@ -44,20 +50,30 @@ type CreateSongParams struct {
 	ReleaseDate date.Date
 	Text        string
 	Link        string
+	Language    string
+	Structure   []byte
+}
+
+type CreateSongRow struct {
+	ID       uuid.UUID
+	Version  int32
+	Inserted bool
 }
 
 // songs.sql
-func (q *Queries) CreateSong(ctx context.Context, arg CreateSongParams) (uuid.UUID, error) {
+func (q *Queries) CreateSong(ctx context.Context, arg CreateSongParams) (CreateSongRow, error) {
 	row := q.db.QueryRow(ctx, createSong,
 		arg.Name,
 		arg.GroupID,
 		arg.ReleaseDate,
 		arg.Text,
 		arg.Link,
+		arg.Language,
+		arg.Structure,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	var i CreateSongRow
+	err := row.Scan(&i.ID, &i.Version, &i.Inserted)
+	return i, err
 }
 
 const deleteSong = `-- name: DeleteSong :one
@@ -80,8 +96,8 @@ func (q *Queries) DeleteSong(ctx context.Context, id uuid.UUID) (*time.Time, err
 
 const getSongByID = `-- name: GetSongByID :one
 SELECT
-    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at,
-    g.id, g.name, g.deleted_at
+    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at, s.search_vector, s.version, s.refreshed_at, s.structure, s.language, s.group_search_vector,
+    g.id, g.name, g.deleted_at, g.search_vector
 FROM 
     songs s
 JOIN
@@ -108,70 +124,189 @@ func (q *Queries) GetSongByID(ctx context.Context, id uuid.UUID) (GetSongByIDRow
 		&i.Song.Text,
 		&i.Song.Link,
 		&i.Song.DeletedAt,
+		&i.Song.SearchVector,
+		&i.Song.Version,
+		&i.Song.RefreshedAt,
+		&i.Song.Structure,
+		&i.Song.Language,
+		&i.Song.GroupSearchVector,
 		&i.Group.ID,
 		&i.Group.Name,
 		&i.Group.DeletedAt,
+		&i.Group.SearchVector,
 	)
 	return i, err
 }
 
-const listSong = `-- name: ListSong :many
+const getSongByIDForUpdate = `-- name: GetSongByIDForUpdate :one
 SELECT
-    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at,
-    g.id, g.name, g.deleted_at
+    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at, s.search_vector, s.version, s.refreshed_at, s.structure, s.language, s.group_search_vector,
+    g.id, g.name, g.deleted_at, g.search_vector
 FROM 
     songs s
 JOIN
     groups g ON s.group_id = g.id
+WHERE
+    s.id = $1
+    AND s.deleted_at IS NULL
+    AND g.deleted_at IS NULL
+FOR UPDATE OF s
+`
+
+type GetSongByIDForUpdateRow struct {
+	Song  Song
+	Group Group
+}
+
+func (q *Queries) GetSongByIDForUpdate(ctx context.Context, id uuid.UUID) (GetSongByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getSongByIDForUpdate, id)
+	var i GetSongByIDForUpdateRow
+	err := row.Scan(
+		&i.Song.ID,
+		&i.Song.Name,
+		&i.Song.GroupID,
+		&i.Song.ReleaseDate,
+		&i.Song.Text,
+		&i.Song.Link,
+		&i.Song.DeletedAt,
+		&i.Song.SearchVector,
+		&i.Song.Version,
+		&i.Song.RefreshedAt,
+		&i.Song.Structure,
+		&i.Song.Language,
+		&i.Song.GroupSearchVector,
+		&i.Group.ID,
+		&i.Group.Name,
+		&i.Group.DeletedAt,
+		&i.Group.SearchVector,
+	)
+	return i, err
+}
+
+const incrementSongVersion = `-- name: IncrementSongVersion :one
+UPDATE
+    songs
+SET
+    version = version + 1
+WHERE
+    id = $1
+RETURNING
+    version
+`
+
+func (q *Queries) IncrementSongVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementSongVersion, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const listDeletedSong = `-- name: ListDeletedSong :many
+SELECT
+    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at, s.search_vector, s.version, s.refreshed_at, s.structure, s.language, s.group_search_vector,
+    g.id, g.name, g.deleted_at, g.search_vector
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
+WHERE
+    s.deleted_at IS NOT NULL
+    AND g.deleted_at IS NULL
+ORDER BY
+    s.deleted_at DESC,
+    s.id
+LIMIT
+    $2
+OFFSET
+    $1
+`
+
+type ListDeletedSongParams struct {
+	Offset int32
+	Limit  *int32
+}
+
+type ListDeletedSongRow struct {
+	Song  Song
+	Group Group
+}
+
+func (q *Queries) ListDeletedSong(ctx context.Context, arg ListDeletedSongParams) ([]ListDeletedSongRow, error) {
+	rows, err := q.db.Query(ctx, listDeletedSong, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDeletedSongRow{}
+	for rows.Next() {
+		var i ListDeletedSongRow
+		if err := rows.Scan(
+			&i.Song.ID,
+			&i.Song.Name,
+			&i.Song.GroupID,
+			&i.Song.ReleaseDate,
+			&i.Song.Text,
+			&i.Song.Link,
+			&i.Song.DeletedAt,
+			&i.Song.SearchVector,
+			&i.Song.Version,
+			&i.Song.RefreshedAt,
+			&i.Song.Structure,
+			&i.Song.Language,
+			&i.Song.GroupSearchVector,
+			&i.Group.ID,
+			&i.Group.Name,
+			&i.Group.DeletedAt,
+			&i.Group.SearchVector,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleSongs = `-- name: ListStaleSongs :many
+SELECT
+    s.id, s.name, s.group_id, s.release_date, s.text, s.link, s.deleted_at, s.search_vector, s.version, s.refreshed_at, s.structure, s.language, s.group_search_vector,
+    g.id, g.name, g.deleted_at, g.search_vector
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id
 WHERE
     s.deleted_at IS NULL
     AND g.deleted_at IS NULL
-    AND ($1::VARCHAR(255)[] IS NULL OR s.name = ANY($1::VARCHAR(255)[]))
-    AND ($2::VARCHAR(255)[] IS NULL OR g.name = ANY($2::VARCHAR(255)[]))
-    AND ($3::DATE IS NULL OR s.release_date >= $3::DATE)
-    AND ($4::DATE IS NULL OR s.release_date <= $4::DATE)
-    AND ($5::TEXT[] IS NULL OR s.text = ANY($5::TEXT[]))
-    AND ($6::TEXT[] IS NULL OR s.link = ANY($6::TEXT[]))
-LIMIT 
-    $8
-OFFSET 
-    $7
+    AND s.refreshed_at < NOW() - $1::INTERVAL
+ORDER BY
+    s.refreshed_at,
+    s.id
+LIMIT
+    $2
 `
 
-type ListSongParams struct {
-	Name            []string
-	Group           []string
-	ReleaseDateFrom *date.Date
-	ReleaseDateTo   *date.Date
-	Text            []string
-	Link            []string
-	Offset          int32
-	Limit           *int32
+type ListStaleSongsParams struct {
+	MaxAge    pgtype.Interval
+	BatchSize int32
 }
 
-type ListSongRow struct {
+type ListStaleSongsRow struct {
 	Song  Song
 	Group Group
 }
 
-func (q *Queries) ListSong(ctx context.Context, arg ListSongParams) ([]ListSongRow, error) {
-	rows, err := q.db.Query(ctx, listSong,
-		arg.Name,
-		arg.Group,
-		arg.ReleaseDateFrom,
-		arg.ReleaseDateTo,
-		arg.Text,
-		arg.Link,
-		arg.Offset,
-		arg.Limit,
-	)
+func (q *Queries) ListStaleSongs(ctx context.Context, arg ListStaleSongsParams) ([]ListStaleSongsRow, error) {
+	rows, err := q.db.Query(ctx, listStaleSongs, arg.MaxAge, arg.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListSongRow{}
+	items := []ListStaleSongsRow{}
 	for rows.Next() {
-		var i ListSongRow
+		var i ListStaleSongsRow
 		if err := rows.Scan(
 			&i.Song.ID,
 			&i.Song.Name,
@@ -180,9 +315,16 @@ func (q *Queries) ListSong(ctx context.Context, arg ListSongParams) ([]ListSongR
 			&i.Song.Text,
 			&i.Song.Link,
 			&i.Song.DeletedAt,
+			&i.Song.SearchVector,
+			&i.Song.Version,
+			&i.Song.RefreshedAt,
+			&i.Song.Structure,
+			&i.Song.Language,
+			&i.Song.GroupSearchVector,
 			&i.Group.ID,
 			&i.Group.Name,
 			&i.Group.DeletedAt,
+			&i.Group.SearchVector,
 		); err != nil {
 			return nil, err
 		}
@@ -194,7 +336,90 @@ func (q *Queries) ListSong(ctx context.Context, arg ListSongParams) ([]ListSongR
 	return items, nil
 }
 
-const updateSong = `-- name: UpdateSong :exec
+const markSongRefreshed = `-- name: MarkSongRefreshed :exec
+UPDATE
+    songs
+SET
+    refreshed_at = NOW()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkSongRefreshed(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markSongRefreshed, id)
+	return err
+}
+
+const purgeSongs = `-- name: PurgeSongs :many
+WITH expired AS (
+    SELECT
+        s.id
+    FROM
+        songs s
+    JOIN
+        groups g ON s.group_id = g.id
+    WHERE
+        s.deleted_at < NOW() - $1::INTERVAL
+        OR g.deleted_at < NOW() - $1::INTERVAL
+    LIMIT
+        $2
+    FOR UPDATE OF s SKIP LOCKED
+)
+DELETE FROM
+    songs
+WHERE
+    id IN (SELECT id FROM expired)
+RETURNING
+    group_id
+`
+
+type PurgeSongsParams struct {
+	Retention pgtype.Interval
+	BatchSize int32
+}
+
+func (q *Queries) PurgeSongs(ctx context.Context, arg PurgeSongsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, purgeSongs, arg.Retention, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var group_id uuid.UUID
+		if err := rows.Scan(&group_id); err != nil {
+			return nil, err
+		}
+		items = append(items, group_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreSong = `-- name: RestoreSong :one
+UPDATE
+    songs s
+SET
+    deleted_at = NULL
+FROM
+    groups g
+WHERE
+    s.id = $1
+    AND s.group_id = g.id
+    AND g.deleted_at IS NULL
+RETURNING
+    s.id
+`
+
+func (q *Queries) RestoreSong(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, restoreSong, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateSong = `-- name: UpdateSong :one
 UPDATE 
     songs 
 SET 
@@ -202,9 +427,14 @@ SET
   group_id = $3,
   release_date = $4,
   text = $5,
-  link = $6
+  link = $6,
+  language = $7,
+  structure = $8,
+  version = version + 1
 WHERE
     id = $1
+RETURNING
+    version
 `
 
 type UpdateSongParams struct {
@@ -214,16 +444,22 @@ type UpdateSongParams struct {
 	ReleaseDate date.Date
 	Text        string
 	Link        string
+	Language    string
+	Structure   []byte
 }
 
-func (q *Queries) UpdateSong(ctx context.Context, arg UpdateSongParams) error {
-	_, err := q.db.Exec(ctx, updateSong,
+func (q *Queries) UpdateSong(ctx context.Context, arg UpdateSongParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateSong,
 		arg.ID,
 		arg.Name,
 		arg.GroupID,
 		arg.ReleaseDate,
 		arg.Text,
 		arg.Link,
+		arg.Language,
+		arg.Structure,
 	)
-	return err
+	var version int32
+	err := row.Scan(&version)
+	return version, err
 }
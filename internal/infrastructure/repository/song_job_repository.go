@@ -0,0 +1,209 @@
+package pgrepo
+
+import (
+	"context"
+	"log/slog"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+	"song-service/internal/infrastructure/database/postgres"
+	"song-service/internal/infrastructure/repository/queries"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SongJobRepository struct {
+	txManager postgres.TransactionManager
+	logger    *slog.Logger
+	tracer    trace.Tracer
+}
+
+func NewSongJobRepository(txManager postgres.TransactionManager, logger *slog.Logger, tracer trace.Tracer) *SongJobRepository {
+	return &SongJobRepository{
+		txManager: txManager,
+		logger:    logger,
+		tracer:    tracer,
+	}
+}
+
+func (r *SongJobRepository) Create(ctx context.Context, job models.SongJob) (models.SongJob, error) {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.Create")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.CreateSongJobParams{
+		GroupName:   job.Group,
+		Name:        job.Song,
+		MaxAttempts: job.MaxAttempts,
+	}
+
+	row, err := querier.CreateSongJob(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongJob{}, err
+	}
+
+	return songJobFromRow(row), nil
+}
+
+func (r *SongJobRepository) GetByID(ctx context.Context, id uuid.UUID) (models.SongJob, error) {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.GetByID")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	row, err := querier.GetSongJobByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SongJob{}, errors.Wrapf(repo.ErrObjectNotFound, "job with id = %s not found", id.String())
+		}
+
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongJob{}, err
+	}
+
+	return songJobFromRow(row), nil
+}
+
+// Claim marks up to batchSize due jobs as running for the lease duration and returns them.
+// Jobs locked by other transactions are skipped, and running jobs whose lease has expired
+// are claimed again if they have attempts left, otherwise they are marked dead.
+func (r *SongJobRepository) Claim(ctx context.Context, lease time.Duration, batchSize int32) ([]models.SongJob, error) {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.Claim")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	if _, err := querier.FailExpiredSongJobs(ctx); err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	args := queries.ClaimSongJobsParams{
+		Lease:     pgtype.Interval{Microseconds: lease.Microseconds(), Valid: true},
+		BatchSize: batchSize,
+	}
+
+	rows, err := querier.ClaimSongJobs(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	jobList := make([]models.SongJob, 0, len(rows))
+	for _, row := range rows {
+		jobList = append(jobList, songJobFromRow(row))
+	}
+
+	return jobList, nil
+}
+
+func (r *SongJobRepository) Complete(ctx context.Context, job models.SongJob, songID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.Complete")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.CompleteSongJobParams{
+		ID:       job.ID,
+		Attempts: job.Attempts,
+		SongID:   &songID,
+	}
+
+	count, err := querier.CompleteSongJob(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	if count == 0 {
+		return errors.Wrapf(repo.ErrObjectNotFound, "running job with id = %s and attempt = %d not found", job.ID.String(), job.Attempts)
+	}
+
+	return nil
+}
+
+func (r *SongJobRepository) Retry(ctx context.Context, job models.SongJob, backoff time.Duration, reason string) error {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.Retry")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.RetrySongJobParams{
+		ID:       job.ID,
+		Attempts: job.Attempts,
+		Error:    &reason,
+		Backoff:  pgtype.Interval{Microseconds: backoff.Microseconds(), Valid: true},
+	}
+
+	count, err := querier.RetrySongJob(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	if count == 0 {
+		return errors.Wrapf(repo.ErrObjectNotFound, "running job with id = %s and attempt = %d not found", job.ID.String(), job.Attempts)
+	}
+
+	return nil
+}
+
+func (r *SongJobRepository) Fail(ctx context.Context, job models.SongJob, reason string) error {
+	ctx, span := r.tracer.Start(ctx, "SongJobRepository.Fail")
+	defer span.End()
+
+	db := r.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.FailSongJobParams{
+		ID:       job.ID,
+		Attempts: job.Attempts,
+		Error:    &reason,
+	}
+
+	count, err := querier.FailSongJob(ctx, args)
+	if err != nil {
+		r.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	if count == 0 {
+		return errors.Wrapf(repo.ErrObjectNotFound, "running job with id = %s and attempt = %d not found", job.ID.String(), job.Attempts)
+	}
+
+	return nil
+}
+
+func songJobFromRow(row queries.SongJob) models.SongJob {
+	return models.SongJob{
+		ID:          row.ID,
+		Group:       row.GroupName,
+		Song:        row.Name,
+		Status:      row.Status,
+		Attempts:    row.Attempts,
+		MaxAttempts: row.MaxAttempts,
+		Error:       row.Error,
+		SongID:      row.SongID,
+		RunTime:     row.RunAt,
+		CreatedTime: row.CreatedAt,
+		UpdatedTime: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,342 @@
+package pgrepo
+
+import (
+	"fmt"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/lyrics"
+	"strings"
+)
+
+const (
+	searchConfig = "english"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+var songSortColumns = map[string]string{
+	repo.SongSortName:        "s.name",
+	repo.SongSortGroup:       "g.name",
+	repo.SongSortReleaseDate: "s.release_date",
+}
+
+var songSortCasts = map[string]string{
+	repo.SongSortName:        "TEXT",
+	repo.SongSortGroup:       "TEXT",
+	repo.SongSortReleaseDate: "DATE",
+	repo.SongSortRelevance:   "REAL",
+	repo.SongSortSimilarity:  "REAL",
+}
+
+// songListQuery builds the song list query from a filter, sort order and pagination.
+// Every user supplied value is passed as a bind argument, only whitelisted column
+// names and expressions are written into the query text.
+type songListQuery struct {
+	args []any
+
+	from  []string
+	verse string
+	where []string
+	order []string
+	limit string
+
+	rank       string
+	headline   string
+	similarity []string
+}
+
+func newSongListQuery(filter *repo.SongFilter, pagination *repo.Pagination) *songListQuery {
+	q := &songListQuery{
+		from: []string{
+			"songs s",
+			"JOIN groups g ON s.group_id = g.id",
+		},
+		where: []string{
+			"s.deleted_at IS NULL",
+			"g.deleted_at IS NULL",
+		},
+	}
+
+	if filter != nil {
+		q.applyFilter(*filter)
+	}
+
+	if pagination == nil {
+		pagination = &repo.Pagination{}
+	}
+
+	sort := repo.EffectiveSongSort(filter, pagination.Sort)
+
+	if pagination.Cursor != nil {
+		q.applyCursor(sort, *pagination.Cursor)
+	}
+
+	q.applySort(sort, pagination.Cursor != nil && pagination.Cursor.Backward)
+	q.applyPagination(*pagination)
+
+	return q
+}
+
+func (q *songListQuery) bind(value any) string {
+	q.args = append(q.args, value)
+
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *songListQuery) applyFilter(filter repo.SongFilter) {
+	if filter.Query != "" {
+		query := q.bind(filter.Query)
+
+		q.from = append(q.from, fmt.Sprintf("CROSS JOIN websearch_to_tsquery('%s', %s) AS search(query)", searchConfig, query))
+		q.verse = fmt.Sprintf(`CROSS JOIN LATERAL (
+        SELECT v.text
+        FROM regexp_split_to_table(s.text, E'\n\n') AS v(text)
+        ORDER BY ts_rank(to_tsvector('%s', v.text), search.query) DESC
+        LIMIT 1
+    ) verse`, searchConfig)
+		q.where = append(q.where, "(s.search_vector || s.group_search_vector) @@ search.query")
+
+		q.rank = "ts_rank_cd(s.search_vector || s.group_search_vector, search.query)"
+		q.headline = fmt.Sprintf("ts_headline('%s', verse.text, search.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')", searchConfig)
+	}
+
+	q.applyMatch("s.name", filter.Name, filter.NameMode)
+	q.applyMatch("g.name", filter.Group, filter.GroupMode)
+	q.applyMatch("s.link", filter.Link, filter.LinkMode)
+
+	if filter.ReleaseDateFrom != nil {
+		q.where = append(q.where, fmt.Sprintf("s.release_date >= %s::DATE", q.bind(filter.ReleaseDateFrom)))
+	}
+
+	if filter.ReleaseDateTo != nil {
+		q.where = append(q.where, fmt.Sprintf("s.release_date <= %s::DATE", q.bind(filter.ReleaseDateTo)))
+	}
+
+	if filter.Text != nil {
+		q.where = append(q.where, fmt.Sprintf("s.text = ANY(%s::TEXT[])", q.bind(filter.Text)))
+	}
+
+	if filter.Language != nil {
+		q.applyLanguage(filter.Language)
+	}
+}
+
+// applyLanguage matches the translations the same way a song is looked up in a language:
+// by the language itself, the more general languages it falls back to, e.g. "de" for
+// "de-CH", and the regional variants of it, e.g. "de-AT" for "de".
+func (q *songListQuery) applyLanguage(languages []string) {
+	var (
+		tags     = make([]string, 0, len(languages))
+		patterns = make([]string, 0, len(languages))
+	)
+
+	for _, language := range languages {
+		fallbacks, err := lyrics.LanguageFallbacks(language)
+		if err != nil {
+			fallbacks = []string{language}
+		}
+
+		tags = append(tags, fallbacks...)
+		patterns = append(patterns, likeEscaper.Replace(language)+"-%")
+	}
+
+	q.where = append(q.where, fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM song_lyrics l WHERE l.song_id = s.id AND (l.language = ANY(%s::TEXT[]) OR l.language LIKE ANY(%s::TEXT[])))",
+		q.bind(tags),
+		q.bind(patterns),
+	))
+}
+
+func (q *songListQuery) applyMatch(column string, values []string, mode repo.MatchMode) {
+	if values == nil {
+		return
+	}
+
+	switch mode {
+	case repo.MatchModeFuzzy:
+		arg := q.bind(values)
+
+		q.where = append(q.where, fmt.Sprintf("EXISTS (SELECT 1 FROM unnest(%s::TEXT[]) v WHERE %s %% v)", arg, column))
+		q.similarity = append(q.similarity, fmt.Sprintf("(SELECT MAX(similarity(%s, v)) FROM unnest(%s::TEXT[]) v)", column, arg))
+	case repo.MatchModeInsensitive, repo.MatchModePrefix, repo.MatchModeContains:
+		q.where = append(q.where, fmt.Sprintf("%s ILIKE ANY(%s::TEXT[])", column, q.bind(likePatterns(values, mode))))
+	default:
+		q.where = append(q.where, fmt.Sprintf("%s = ANY(%s::TEXT[])", column, q.bind(values)))
+	}
+}
+
+func (q *songListQuery) applySort(sort []repo.SortField, backward bool) {
+	for _, field := range sort {
+		expression := q.sortExpression(field.Field)
+		if expression == "" {
+			continue
+		}
+
+		q.order = append(q.order, fmt.Sprintf("%s %s", expression, sortDirection(field.Descending != backward)))
+	}
+
+	q.order = append(q.order, fmt.Sprintf("s.id %s", sortDirection(backward)))
+}
+
+// applyCursor keeps only rows located after the cursor in the sort order
+// (or before it for a backward cursor) using a row-by-row keyset comparison.
+func (q *songListQuery) applyCursor(sort []repo.SortField, cursor repo.Cursor) {
+	var (
+		equal      []string
+		conditions []string
+	)
+
+	for i, field := range sort {
+		expression, cast := q.sortExpression(field.Field), songSortCasts[field.Field]
+		if expression == "" || i >= len(cursor.Values) {
+			continue
+		}
+
+		expression = fmt.Sprintf("(%s)::%s", expression, cast)
+		value := fmt.Sprintf("%s::TEXT::%s", q.bind(cursor.Values[i]), cast)
+
+		operator := ">"
+		if field.Descending != cursor.Backward {
+			operator = "<"
+		}
+
+		conditions = append(conditions, keysetCondition(equal, fmt.Sprintf("%s %s %s", expression, operator, value)))
+		equal = append(equal, fmt.Sprintf("%s = %s", expression, value))
+	}
+
+	operator := ">"
+	if cursor.Backward {
+		operator = "<"
+	}
+
+	conditions = append(conditions, keysetCondition(equal, fmt.Sprintf("s.id %s %s::UUID", operator, q.bind(cursor.ID))))
+
+	q.where = append(q.where, fmt.Sprintf("(\n        %s\n    )", strings.Join(conditions, "\n        OR ")))
+}
+
+func keysetCondition(equal []string, compare string) string {
+	return fmt.Sprintf("(%s)", strings.Join(append(equal[:len(equal):len(equal)], compare), " AND "))
+}
+
+func sortDirection(descending bool) string {
+	if descending {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+func (q *songListQuery) sortExpression(field string) string {
+	switch field {
+	case repo.SongSortRelevance:
+		return q.rank
+	case repo.SongSortSimilarity:
+		return q.similarityExpression()
+	default:
+		return songSortColumns[field]
+	}
+}
+
+func (q *songListQuery) similarityExpression() string {
+	if len(q.similarity) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("GREATEST(%s)", strings.Join(q.similarity, ", "))
+}
+
+func (q *songListQuery) applyPagination(pagination repo.Pagination) {
+	if pagination.Limit > 0 {
+		q.limit += fmt.Sprintf("\nLIMIT %s", q.bind(pagination.Limit))
+	}
+
+	if pagination.Offset > 0 && pagination.Cursor == nil {
+		q.limit += fmt.Sprintf("\nOFFSET %s", q.bind(pagination.Offset))
+	}
+}
+
+func (q *songListQuery) String() string {
+	var (
+		rank       = "NULL"
+		headline   = "NULL"
+		similarity = "NULL"
+	)
+
+	if q.rank != "" {
+		rank = q.rank
+	}
+
+	if q.headline != "" {
+		headline = q.headline
+	}
+
+	if expression := q.similarityExpression(); expression != "" {
+		similarity = expression
+	}
+
+	from := q.from
+	if q.verse != "" {
+		from = append(from[:len(from):len(from)], q.verse)
+	}
+
+	return fmt.Sprintf(`SELECT
+    s.id,
+    s.name,
+    g.name,
+    s.release_date,
+    s.text,
+    s.link,
+    s.language,
+    (%s)::REAL AS rank,
+    (%s)::TEXT AS headline,
+    (%s)::REAL AS similarity
+FROM
+    %s
+WHERE
+    %s
+ORDER BY
+    %s%s`,
+		rank,
+		headline,
+		similarity,
+		strings.Join(from, "\n    "),
+		strings.Join(q.where, "\n    AND "),
+		strings.Join(q.order, ",\n    "),
+		q.limit,
+	)
+}
+
+// CountString returns the query counting all rows matching the filter, sort order and pagination are ignored.
+func (q *songListQuery) CountString() string {
+	return fmt.Sprintf(`SELECT
+    COUNT(*)
+FROM
+    %s
+WHERE
+    %s`,
+		strings.Join(q.from, "\n    "),
+		strings.Join(q.where, "\n    AND "),
+	)
+}
+
+func (q *songListQuery) Args() []any {
+	return q.args
+}
+
+// likePatterns converts filter values to ILIKE patterns for the given match mode.
+func likePatterns(values []string, mode repo.MatchMode) []string {
+	patterns := make([]string, 0, len(values))
+	for _, value := range values {
+		pattern := likeEscaper.Replace(value)
+
+		switch mode {
+		case repo.MatchModePrefix:
+			pattern = pattern + "%"
+		case repo.MatchModeContains:
+			pattern = "%" + pattern + "%"
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns
+}
@@ -1,10 +1,12 @@
 package pgrepo
 
 import (
-	"cmp"
 	"context"
+	"encoding/json"
 	"log/slog"
+	"slices"
 	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/lyrics"
 	"song-service/internal/domain/models"
 	"song-service/internal/infrastructure/database/postgres"
 	"song-service/internal/infrastructure/repository/queries"
@@ -14,6 +16,7 @@ import (
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -47,15 +50,24 @@ func (s *SongRepository) Create(ctx context.Context, song models.Song) (models.S
 			return err
 		}
 
+		song.Structure = lyrics.Analyze(lyrics.Parse(song.Text))
+
+		structure, err := json.Marshal(song.Structure)
+		if err != nil {
+			return err
+		}
+
 		songArgs := queries.CreateSongParams{
 			Name:        song.Name,
 			GroupID:     groupID,
 			ReleaseDate: song.ReleaseDate,
 			Text:        song.Text,
 			Link:        song.Link,
+			Language:    song.Language,
+			Structure:   structure,
 		}
 
-		songID, err := querier.CreateSong(ctx, songArgs)
+		row, err := querier.CreateSong(ctx, songArgs)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return errors.Wrapf(repo.ErrDuplicate, "song with name = %s and group = %s already exists", song.Name, song.Group)
@@ -66,9 +78,14 @@ func (s *SongRepository) Create(ctx context.Context, song models.Song) (models.S
 			return err
 		}
 
-		song.ID = songID
+		song.ID = row.ID
+		song.Version = row.Version
 
-		return nil
+		if !row.Inserted {
+			return nil
+		}
+
+		return s.createRevision(ctx, querier, song.ID, models.SongRevisionCreate)
 	}); err != nil {
 		return models.Song{}, err
 	}
@@ -76,6 +93,77 @@ func (s *SongRepository) Create(ctx context.Context, song models.Song) (models.S
 	return song, nil
 }
 
+// CreateBatch creates all songs with a single round trip. A song that already exists is
+// reported as a duplicate in its result, any other error fails the whole batch.
+func (s *SongRepository) CreateBatch(ctx context.Context, songList []models.Song) ([]repo.SongBatchResult, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.CreateBatch")
+	defer span.End()
+
+	results := make([]repo.SongBatchResult, len(songList))
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := s.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		args := make([]queries.CreateSongBatchParams, 0, len(songList))
+		for i, song := range songList {
+			songList[i].Structure = lyrics.Analyze(lyrics.Parse(song.Text))
+
+			structure, err := json.Marshal(songList[i].Structure)
+			if err != nil {
+				return err
+			}
+
+			args = append(args, queries.CreateSongBatchParams{
+				GroupName:   song.Group,
+				Name:        song.Name,
+				ReleaseDate: song.ReleaseDate,
+				Text:        song.Text,
+				Link:        song.Link,
+				Language:    song.Language,
+				Structure:   structure,
+			})
+		}
+
+		var batchErr error
+
+		querier.CreateSongBatch(ctx, args).QueryRow(func(i int, row queries.CreateSongBatchRow, err error) {
+			song := songList[i]
+
+			switch {
+			case errors.Is(err, pgx.ErrNoRows):
+				results[i].Err = errors.Wrapf(repo.ErrDuplicate, "song with name = %s and group = %s already exists", song.Name, song.Group)
+			case err != nil:
+				if batchErr == nil {
+					batchErr = err
+				}
+			case !row.Inserted:
+				song.ID = row.ID
+				song.Version = row.Version
+
+				results[i].Err = errors.Wrapf(repo.ErrDuplicate, "song with name = %s and group = %s already exists", song.Name, song.Group)
+			default:
+				song.ID = row.ID
+				song.Version = row.Version
+			}
+
+			results[i].Song = song
+		})
+
+		if batchErr != nil {
+			s.logger.Warn("execute query failed", slog.String("error", batchErr.Error()))
+
+			return batchErr
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (s *SongRepository) GetByID(ctx context.Context, id uuid.UUID) (models.Song, error) {
 	ctx, span := s.tracer.Start(ctx, "SongRepository.GetByID")
 	defer span.End()
@@ -101,70 +189,142 @@ func (s *SongRepository) GetByID(ctx context.Context, id uuid.UUID) (models.Song
 		ReleaseDate: row.Song.ReleaseDate,
 		Text:        row.Song.Text,
 		Link:        row.Song.Link,
+		Language:    row.Song.Language,
+		Version:     row.Song.Version,
+	}
+
+	if err := json.Unmarshal(row.Song.Structure, &song.Structure); err != nil {
+		s.logger.Warn("decode song structure failed", slog.String("error", err.Error()))
+
+		return models.Song{}, err
 	}
 
 	return song, nil
 }
 
-func (s *SongRepository) List(ctx context.Context, filter *repo.SongFilter, pagination *repo.Pagination) ([]models.Song, error) {
-	ctx, span := s.tracer.Start(ctx, "SongRepository.List")
+// GetByIDForUpdate returns the song and locks it until the end of the transaction.
+func (s *SongRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.GetByIDForUpdate")
 	defer span.End()
 
 	db := s.txManager.TxOrDB(ctx)
 	querier := queries.New(db)
 
-	var args queries.ListSongParams
+	row, err := querier.GetSongByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Song{}, errors.Wrapf(repo.ErrObjectNotFound, "song with id = %s not found", id.String())
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
 
-	if filter != nil {
-		args.Name = filter.Name
-		args.Group = filter.Group
-		args.Text = filter.Text
-		args.Link = filter.Link
-		args.ReleaseDateFrom = filter.ReleaseDateFrom
-		args.ReleaseDateTo = filter.ReleaseDateTo
+		return models.Song{}, err
 	}
 
-	if pagination != nil {
-		if pagination.Limit > 0 {
-			args.Limit = &pagination.Limit
-		}
+	song := models.Song{
+		ID:          row.Song.ID,
+		Name:        row.Song.Name,
+		Group:       row.Group.Name,
+		ReleaseDate: row.Song.ReleaseDate,
+		Text:        row.Song.Text,
+		Link:        row.Song.Link,
+		Language:    row.Song.Language,
+		Version:     row.Song.Version,
+	}
 
-		args.Offset = pagination.Offset
+	if err := json.Unmarshal(row.Song.Structure, &song.Structure); err != nil {
+		s.logger.Warn("decode song structure failed", slog.String("error", err.Error()))
+
+		return models.Song{}, err
 	}
 
-	rows, err := querier.ListSong(ctx, args)
+	return song, nil
+}
+
+func (s *SongRepository) List(ctx context.Context, filter *repo.SongFilter, pagination *repo.Pagination) ([]models.SongMatch, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.List")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+
+	query := newSongListQuery(filter, pagination)
+
+	rows, err := db.Query(ctx, query.String(), query.Args()...)
 	if err != nil {
 		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
 
 		return nil, err
 	}
-
-	songList := make([]models.Song, 0, len(rows))
-	for _, row := range rows {
-		song := models.Song{
-			ID:          row.Song.ID,
-			Name:        row.Song.Name,
-			Group:       row.Group.Name,
-			ReleaseDate: row.Song.ReleaseDate,
-			Text:        row.Song.Text,
-			Link:        row.Song.Link,
+	defer rows.Close()
+
+	songList := make([]models.SongMatch, 0)
+	for rows.Next() {
+		var song models.SongMatch
+
+		if err := rows.Scan(
+			&song.ID,
+			&song.Name,
+			&song.Group,
+			&song.ReleaseDate,
+			&song.Text,
+			&song.Link,
+			&song.Language,
+			&song.Rank,
+			&song.Headline,
+			&song.Similarity,
+		); err != nil {
+			s.logger.Warn("scan row failed", slog.String("error", err.Error()))
+
+			return nil, err
 		}
 
 		songList = append(songList, song)
 	}
 
+	if err := rows.Err(); err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if pagination != nil && pagination.Cursor != nil && pagination.Cursor.Backward {
+		slices.Reverse(songList)
+	}
+
 	return songList, nil
 }
 
+func (s *SongRepository) Count(ctx context.Context, filter *repo.SongFilter) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.Count")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+
+	query := newSongListQuery(filter, nil)
+
+	var count int64
+	if err := db.QueryRow(ctx, query.CountString(), query.Args()...).Scan(&count); err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (s *SongRepository) Update(ctx context.Context, song models.Song) (models.Song, error) {
 	ctx, span := s.tracer.Start(ctx, "SongRepository.Update")
 	defer span.End()
 
+	return s.update(ctx, song, models.SongRevisionUpdate)
+}
+
+func (s *SongRepository) update(ctx context.Context, song models.Song, operation string) (models.Song, error) {
 	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
 		db := s.txManager.TxOrDB(ctx)
 		querier := queries.New(db)
 
-		row, err := querier.GetSongByID(ctx, song.ID)
+		row, err := querier.GetSongByIDForUpdate(ctx, song.ID)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return errors.Wrapf(repo.ErrObjectNotFound, "song with id = %s not found", song.ID)
@@ -175,11 +335,16 @@ func (s *SongRepository) Update(ctx context.Context, song models.Song) (models.S
 			return err
 		}
 
-		song.Name = cmp.Or(song.Name, row.Song.Name)
-		song.Group = cmp.Or(song.Group, row.Group.Name)
-		song.ReleaseDate = cmp.Or(song.ReleaseDate, row.Song.ReleaseDate)
-		song.Text = cmp.Or(song.Text, row.Song.Text)
-		song.Link = cmp.Or(song.Link, row.Song.Link)
+		if song.Version != 0 && song.Version != row.Song.Version {
+			return errors.Wrapf(repo.ErrVersionMismatch, "song with id = %s has version %d, expected %d", song.ID, row.Song.Version, song.Version)
+		}
+
+		song.Structure = lyrics.Analyze(lyrics.Parse(song.Text))
+
+		structure, err := json.Marshal(song.Structure)
+		if err != nil {
+			return err
+		}
 
 		songArgs := queries.UpdateSongParams{
 			ID:          song.ID,
@@ -187,6 +352,8 @@ func (s *SongRepository) Update(ctx context.Context, song models.Song) (models.S
 			ReleaseDate: song.ReleaseDate,
 			Text:        song.Text,
 			Link:        song.Link,
+			Language:    song.Language,
+			Structure:   structure,
 		}
 
 		groupID, err := querier.CreateGroup(ctx, song.Group)
@@ -198,7 +365,17 @@ func (s *SongRepository) Update(ctx context.Context, song models.Song) (models.S
 
 		songArgs.GroupID = groupID
 
-		if err := querier.UpdateSong(ctx, songArgs); err != nil {
+		// Synced lyrics are only kept while the text is derived from them.
+		if song.Text != row.Song.Text {
+			if err := querier.DeleteSongSyncedLyrics(ctx, song.ID); err != nil {
+				s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+				return err
+			}
+		}
+
+		version, err := querier.UpdateSong(ctx, songArgs)
+		if err != nil {
 			s.logger.Warn("execute query failed", slog.String("error", err.Error()))
 
 			var pgErr *pgconn.PgError
@@ -212,7 +389,9 @@ func (s *SongRepository) Update(ctx context.Context, song models.Song) (models.S
 			return err
 		}
 
-		return nil
+		song.Version = version
+
+		return s.createRevision(ctx, querier, song.ID, operation)
 	}); err != nil {
 		return models.Song{}, err
 	}
@@ -230,6 +409,15 @@ func (s *SongRepository) Delete(ctx context.Context, id uuid.UUID) (*time.Time,
 		db := s.txManager.TxOrDB(ctx)
 		querier := queries.New(db)
 
+		_, err := querier.GetSongByIDForUpdate(ctx, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		active := err == nil
+
 		deletedAt, err := querier.DeleteSong(ctx, id)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
@@ -243,10 +431,536 @@ func (s *SongRepository) Delete(ctx context.Context, id uuid.UUID) (*time.Time,
 
 		deletedTime = deletedAt
 
-		return nil
+		if !active {
+			return nil
+		}
+
+		return s.createRevision(ctx, querier, id, models.SongRevisionDelete)
 	}); err != nil {
 		return nil, err
 	}
 
 	return deletedTime, nil
 }
+
+func (s *SongRepository) ListDeleted(ctx context.Context, pagination *repo.Pagination) ([]models.DeletedSong, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.ListDeleted")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	var args queries.ListDeletedSongParams
+
+	if pagination != nil {
+		if pagination.Limit > 0 {
+			args.Limit = &pagination.Limit
+		}
+
+		args.Offset = pagination.Offset
+	}
+
+	rows, err := querier.ListDeletedSong(ctx, args)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	songList := make([]models.DeletedSong, 0, len(rows))
+	for _, row := range rows {
+		song := models.DeletedSong{
+			Song: models.Song{
+				ID:          row.Song.ID,
+				Name:        row.Song.Name,
+				Group:       row.Group.Name,
+				ReleaseDate: row.Song.ReleaseDate,
+				Text:        row.Song.Text,
+				Link:        row.Song.Link,
+				Language:    row.Song.Language,
+			},
+			DeletedTime: *row.Song.DeletedAt,
+		}
+
+		songList = append(songList, song)
+	}
+
+	return songList, nil
+}
+
+func (s *SongRepository) Restore(ctx context.Context, id uuid.UUID) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.Restore")
+	defer span.End()
+
+	var restoredSong models.Song
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		db := s.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		_, err := querier.GetSongByID(ctx, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		active := err == nil
+
+		if _, err := querier.RestoreSong(ctx, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errors.Wrapf(repo.ErrObjectNotFound, "song with id = %s not found", id.String())
+			}
+
+			s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			var pgErr *pgconn.PgError
+
+			if errors.As(err, &pgErr) {
+				if pgErr.Code == pgerrcode.UniqueViolation {
+					return errors.Wrapf(repo.ErrDuplicate, "active song with the same name and group as song with id = %s already exists", id.String())
+				}
+			}
+
+			return err
+		}
+
+		if !active {
+			if err := s.createRevision(ctx, querier, id, models.SongRevisionRestore); err != nil {
+				return err
+			}
+		}
+
+		restoredSong, err = s.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return restoredSong, nil
+}
+
+// Purge hard deletes up to batchSize songs deleted, or belonging to a group deleted, longer
+// than retention ago and returns the group IDs of the purged songs.
+func (s *SongRepository) Purge(ctx context.Context, retention time.Duration, batchSize int32) ([]uuid.UUID, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.Purge")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.PurgeSongsParams{
+		Retention: pgtype.Interval{Microseconds: retention.Microseconds(), Valid: true},
+		BatchSize: batchSize,
+	}
+
+	groupIDs, err := querier.PurgeSongs(ctx, args)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	return groupIDs, nil
+}
+
+func (s *SongRepository) ListRevisions(ctx context.Context, id uuid.UUID) ([]models.SongRevision, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.ListRevisions")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	rows, err := querier.ListSongRevision(ctx, id)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.Wrapf(repo.ErrObjectNotFound, "song with id = %s not found", id.String())
+	}
+
+	revisionList := make([]models.SongRevision, 0, len(rows))
+	for _, row := range rows {
+		revisionList = append(revisionList, songRevisionFromRow(row))
+	}
+
+	return revisionList, nil
+}
+
+func (s *SongRepository) GetRevision(ctx context.Context, id uuid.UUID, revision int32) (models.SongRevision, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.GetRevision")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	row, err := querier.GetSongRevision(ctx, queries.GetSongRevisionParams{SongID: id, Revision: revision})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SongRevision{}, errors.Wrapf(repo.ErrObjectNotFound, "revision %d of song with id = %s not found", revision, id.String())
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongRevision{}, err
+	}
+
+	return songRevisionFromRow(row), nil
+}
+
+// Revert updates the song to the state of one of its revisions, recording it as a new
+// revision. If the version of the song is set, the stored song must have it.
+func (s *SongRepository) Revert(ctx context.Context, song models.Song) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.Revert")
+	defer span.End()
+
+	return s.update(ctx, song, models.SongRevisionRevert)
+}
+
+// Refresh updates the song with the details fetched from the song info providers, recording
+// it as a new revision, and marks the song as refreshed.
+func (s *SongRepository) Refresh(ctx context.Context, song models.Song) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.Refresh")
+	defer span.End()
+
+	var refreshedSong models.Song
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		refreshedSong, err = s.update(ctx, song, models.SongRevisionRefresh)
+		if err != nil {
+			return err
+		}
+
+		return s.MarkRefreshed(ctx, song.ID)
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return refreshedSong, nil
+}
+
+func (s *SongRepository) MarkRefreshed(ctx context.Context, id uuid.UUID) error {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.MarkRefreshed")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	if err := querier.MarkSongRefreshed(ctx, id); err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	return nil
+}
+
+// ListStale returns up to batchSize songs refreshed longer than maxAge ago, least recently
+// refreshed first.
+func (s *SongRepository) ListStale(ctx context.Context, maxAge time.Duration, batchSize int32) ([]models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.ListStale")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.ListStaleSongsParams{
+		MaxAge:    pgtype.Interval{Microseconds: maxAge.Microseconds(), Valid: true},
+		BatchSize: batchSize,
+	}
+
+	rows, err := querier.ListStaleSongs(ctx, args)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	songList := make([]models.Song, 0, len(rows))
+	for _, row := range rows {
+		song := models.Song{
+			ID:          row.Song.ID,
+			Name:        row.Song.Name,
+			Group:       row.Group.Name,
+			ReleaseDate: row.Song.ReleaseDate,
+			Text:        row.Song.Text,
+			Link:        row.Song.Link,
+			Language:    row.Song.Language,
+			Version:     row.Song.Version,
+		}
+
+		songList = append(songList, song)
+	}
+
+	return songList, nil
+}
+
+func (s *SongRepository) GetSyncedLyrics(ctx context.Context, id uuid.UUID) (models.SyncedLyrics, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.GetSyncedLyrics")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	data, err := querier.GetSongSyncedLyrics(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SyncedLyrics{}, errors.Wrapf(repo.ErrObjectNotFound, "synced lyrics of song with id = %s not found", id.String())
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SyncedLyrics{}, err
+	}
+
+	var synced models.SyncedLyrics
+
+	if err := json.Unmarshal(data, &synced); err != nil {
+		s.logger.Warn("decode synced lyrics failed", slog.String("error", err.Error()))
+
+		return models.SyncedLyrics{}, err
+	}
+
+	return synced, nil
+}
+
+// SaveSyncedLyrics updates the song, recording it as a new revision, and saves the synced
+// lyrics of the song, replacing the previous ones. The song version is bumped even if only
+// the timings change.
+func (s *SongRepository) SaveSyncedLyrics(ctx context.Context, song models.Song, synced models.SyncedLyrics) (models.Song, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.SaveSyncedLyrics")
+	defer span.End()
+
+	data, err := json.Marshal(synced)
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	var updatedSong models.Song
+
+	if err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		updatedSong, err = s.update(ctx, song, models.SongRevisionSyncedLyrics)
+		if err != nil {
+			return err
+		}
+
+		db := s.txManager.TxOrDB(ctx)
+		querier := queries.New(db)
+
+		args := queries.UpsertSongSyncedLyricsParams{
+			SongID: song.ID,
+			Lyrics: data,
+		}
+
+		if err := querier.UpsertSongSyncedLyrics(ctx, args); err != nil {
+			s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return updatedSong, nil
+}
+
+// IncrementVersion bumps the version of the song without changing its fields, e.g. when
+// a translation changes, so that cached representations of the song become stale.
+func (s *SongRepository) IncrementVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.IncrementVersion")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	version, err := querier.IncrementSongVersion(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.Wrapf(repo.ErrObjectNotFound, "song with id = %s not found", id.String())
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (s *SongRepository) ListTranslations(ctx context.Context, id uuid.UUID) ([]models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.ListTranslations")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	rows, err := querier.ListSongLyrics(ctx, id)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return nil, err
+	}
+
+	translations := make([]models.SongTranslation, 0, len(rows))
+
+	for _, row := range rows {
+		translation, err := s.songTranslationFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, translation)
+	}
+
+	return translations, nil
+}
+
+func (s *SongRepository) GetTranslation(ctx context.Context, id uuid.UUID, language string) (models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.GetTranslation")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.GetSongLyricsParams{
+		SongID:   id,
+		Language: language,
+	}
+
+	row, err := querier.GetSongLyrics(ctx, args)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SongTranslation{}, errors.Wrapf(repo.ErrObjectNotFound, "translation of song with id = %s to %s not found", id.String(), language)
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongTranslation{}, err
+	}
+
+	return s.songTranslationFromRow(row)
+}
+
+// SaveTranslation saves the translation of the song, replacing a previous translation to
+// the same language. The structure of the translated text is detected on save.
+func (s *SongRepository) SaveTranslation(ctx context.Context, id uuid.UUID, translation models.SongTranslation) (models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.SaveTranslation")
+	defer span.End()
+
+	translation.Structure = lyrics.Analyze(lyrics.Parse(translation.Text))
+
+	structure, err := json.Marshal(translation.Structure)
+	if err != nil {
+		return models.SongTranslation{}, err
+	}
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.UpsertSongLyricsParams{
+		SongID:    id,
+		Language:  translation.Language,
+		Text:      translation.Text,
+		Structure: structure,
+	}
+
+	row, err := querier.UpsertSongLyrics(ctx, args)
+	if err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongTranslation{}, err
+	}
+
+	translation.UpdatedTime = row.UpdatedAt
+
+	return translation, nil
+}
+
+// DeleteTranslation deletes the translation of the song and returns it.
+func (s *SongRepository) DeleteTranslation(ctx context.Context, id uuid.UUID, language string) (models.SongTranslation, error) {
+	ctx, span := s.tracer.Start(ctx, "SongRepository.DeleteTranslation")
+	defer span.End()
+
+	db := s.txManager.TxOrDB(ctx)
+	querier := queries.New(db)
+
+	args := queries.DeleteSongLyricsParams{
+		SongID:   id,
+		Language: language,
+	}
+
+	row, err := querier.DeleteSongLyrics(ctx, args)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SongTranslation{}, errors.Wrapf(repo.ErrObjectNotFound, "translation of song with id = %s to %s not found", id.String(), language)
+		}
+
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return models.SongTranslation{}, err
+	}
+
+	return s.songTranslationFromRow(row)
+}
+
+// createRevision saves the current state of the song as its next revision.
+func (s *SongRepository) createRevision(ctx context.Context, querier *queries.Queries, id uuid.UUID, operation string) error {
+	args := queries.CreateSongRevisionParams{
+		SongID:    id,
+		Operation: operation,
+	}
+
+	if _, err := querier.CreateSongRevision(ctx, args); err != nil {
+		s.logger.Warn("execute query failed", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *SongRepository) songTranslationFromRow(row queries.SongLyric) (models.SongTranslation, error) {
+	translation := models.SongTranslation{
+		Language:    row.Language,
+		Text:        row.Text,
+		UpdatedTime: row.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(row.Structure, &translation.Structure); err != nil {
+		s.logger.Warn("decode translation structure failed", slog.String("error", err.Error()))
+
+		return models.SongTranslation{}, err
+	}
+
+	return translation, nil
+}
+
+func songRevisionFromRow(row queries.SongRevision) models.SongRevision {
+	return models.SongRevision{
+		Revision:    row.Revision,
+		Operation:   row.Operation,
+		CreatedTime: row.CreatedAt,
+		Song: models.Song{
+			ID:          row.SongID,
+			Name:        row.Name,
+			Group:       row.GroupName,
+			ReleaseDate: row.ReleaseDate,
+			Text:        row.Text,
+			Link:        row.Link,
+			Language:    row.Language,
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+var (
+	ErrOpen = errors.New("circuit breaker is open")
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half-open"
+)
+
+// CircuitBreaker fails calls fast after failureThreshold consecutive failures. Once
+// openTimeout has passed, a single probe call is let through: its success closes the
+// breaker and its failure opens it again.
+type CircuitBreaker struct {
+	name             string
+	failureThreshold int
+	openTimeout      time.Duration
+	logger           *slog.Logger
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(name string, failureThreshold int, openTimeout time.Duration, logger *slog.Logger) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		logger:           logger,
+		state:            StateClosed,
+	}
+}
+
+// Allow returns ErrOpen if the call must not be made. Every allowed call must be
+// followed by Success, Failure or Ignore.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return ErrOpen
+		}
+
+		b.setState(StateHalfOpen)
+		b.probing = true
+
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrOpen
+		}
+
+		b.probing = true
+
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+
+	if b.state != StateClosed {
+		b.setState(StateClosed)
+	}
+}
+
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+
+	if b.state == StateHalfOpen || (b.state == StateClosed && b.failures >= b.failureThreshold) {
+		b.openedAt = time.Now()
+		b.setState(StateOpen)
+	}
+}
+
+// Ignore releases an allowed call whose outcome says nothing about the protected
+// service, e.g. one cancelled by the caller.
+func (b *CircuitBreaker) Ignore() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *CircuitBreaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen && time.Since(b.openedAt) >= b.openTimeout {
+		return StateHalfOpen
+	}
+
+	return b.state
+}
+
+func (b *CircuitBreaker) setState(state State) {
+	level := slog.LevelWarn
+	if state == StateClosed {
+		level = slog.LevelInfo
+	}
+
+	b.logger.Log(context.Background(), level, "circuit breaker state changed",
+		slog.String("name", b.name),
+		slog.String("from", string(b.state)),
+		slog.String("to", string(state)),
+		slog.Int("failures", b.failures),
+	)
+
+	b.state = state
+}
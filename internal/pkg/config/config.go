@@ -4,6 +4,12 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
+// Validator is implemented by configurations with rules beyond required fields, they are
+// checked once the configuration is read.
+type Validator interface {
+	Validate() error
+}
+
 func NewConfig[T any](configPath string) (*T, error) {
 	var cfg T
 
@@ -11,5 +17,11 @@ func NewConfig[T any](configPath string) (*T, error) {
 		return nil, err
 	}
 
+	if validator, ok := any(&cfg).(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return &cfg, nil
 }
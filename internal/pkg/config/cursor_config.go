@@ -0,0 +1,5 @@
+package config
+
+type Cursor struct {
+	Secret string `env:"CURSOR_SECRET" env-required:"true"`
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration that additionally accepts whole days, e.g. "30d".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	return d.SetValue(string(text))
+}
+
+// SetValue implements cleanenv.Setter for values read from the environment.
+func (d *Duration) SetValue(value string) error {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return fmt.Errorf("parse duration %q: %w", value, err)
+		}
+
+		*d = Duration(time.Duration(n) * 24 * time.Hour)
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
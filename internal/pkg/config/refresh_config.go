@@ -0,0 +1,22 @@
+package config
+
+import "errors"
+
+// Refresh configures re-fetching songs from the song info providers. RateLimit caps
+// provider lookups per second across manual and scheduled refreshes, zero disables it.
+type Refresh struct {
+	Scheduled bool     `yaml:"scheduled"`
+	Interval  Duration `yaml:"interval"   env-required:"true"`
+	MaxAge    Duration `yaml:"max_age"    env-required:"true"`
+	BatchSize int32    `yaml:"batch_size" env-required:"true"`
+	RateLimit float64  `yaml:"rate_limit"`
+}
+
+// Validate rejects a batch size the scheduled refresh could never finish with.
+func (r Refresh) Validate() error {
+	if r.BatchSize <= 0 {
+		return errors.New("refresh batch_size must be positive")
+	}
+
+	return nil
+}
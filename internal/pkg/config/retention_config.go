@@ -0,0 +1,18 @@
+package config
+
+import "errors"
+
+type Retention struct {
+	DeletedSongs  Duration `yaml:"deleted_songs"  env-required:"true"`
+	PurgeInterval Duration `yaml:"purge_interval" env-required:"true"`
+	BatchSize     int32    `yaml:"batch_size"     env-required:"true"`
+}
+
+// Validate rejects a batch size the purge could never finish with.
+func (r Retention) Validate() error {
+	if r.BatchSize <= 0 {
+		return errors.New("retention batch_size must be positive")
+	}
+
+	return nil
+}
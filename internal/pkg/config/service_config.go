@@ -1,8 +0,0 @@
-package config
-
-import "time"
-
-type MusicService struct {
-	Address string        `yaml:"address" env-required:"true"`
-	Timeout time.Duration `yaml:"timeout" env-required:"true"`
-}
@@ -0,0 +1,37 @@
+package config
+
+import "time"
+
+type SongInfo struct {
+	Providers      []SongInfoProvider `yaml:"providers"       env-required:"true"`
+	Retry          Retry              `yaml:"retry"           env-required:"true"`
+	CircuitBreaker CircuitBreaker     `yaml:"circuit_breaker" env-required:"true"`
+	Cache          Cache              `yaml:"cache"           env-required:"true"`
+}
+
+// SongInfoProvider is a Music Service compatible API. Providers with a lower priority
+// value are preferred when their results are merged.
+type SongInfoProvider struct {
+	Name     string        `yaml:"name"`
+	Address  string        `yaml:"address"`
+	Timeout  time.Duration `yaml:"timeout"`
+	Priority int           `yaml:"priority"`
+}
+
+type Retry struct {
+	Attempts   int           `yaml:"attempts"    env-required:"true"`
+	Backoff    time.Duration `yaml:"backoff"     env-required:"true"`
+	MaxBackoff time.Duration `yaml:"max_backoff" env-required:"true"`
+}
+
+type CircuitBreaker struct {
+	FailureThreshold int           `yaml:"failure_threshold" env-required:"true"`
+	OpenTimeout      time.Duration `yaml:"open_timeout"      env-required:"true"`
+}
+
+type Cache struct {
+	Backend     string        `yaml:"backend"       env-required:"true"`
+	Size        int           `yaml:"size"`
+	TTL         time.Duration `yaml:"ttl"           env-required:"true"`
+	NotFoundTTL time.Duration `yaml:"not_found_ttl" env-required:"true"`
+}
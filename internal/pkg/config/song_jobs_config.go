@@ -0,0 +1,10 @@
+package config
+
+type SongJobs struct {
+	Workers      int      `yaml:"workers"       env-required:"true"`
+	PollInterval Duration `yaml:"poll_interval" env-required:"true"`
+	Lease        Duration `yaml:"lease"         env-required:"true"`
+	MaxAttempts  int32    `yaml:"max_attempts"  env-required:"true"`
+	Backoff      Duration `yaml:"backoff"       env-required:"true"`
+	MaxBackoff   Duration `yaml:"max_backoff"   env-required:"true"`
+}
@@ -0,0 +1,73 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Signer encodes values into opaque URL-safe tokens signed with HMAC-SHA256,
+// so clients can pass them back but cannot forge or modify them.
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{
+		key: []byte(secret),
+	}
+}
+
+func (s *Signer) Encode(value any) (string, error) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(s.sign(payload)), nil
+}
+
+func (s *Signer) Decode(token string, value any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidCursor
+	}
+
+	encoding := base64.RawURLEncoding
+
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	if !hmac.Equal(signature, s.sign(payload)) {
+		return ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(payload, value); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+func (s *Signer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,84 @@
+package diff
+
+type Operation string
+
+const (
+	OperationEqual  Operation = "equal"
+	OperationInsert Operation = "insert"
+	OperationDelete Operation = "delete"
+)
+
+type Edit[T any] struct {
+	Operation Operation
+	Value     T
+}
+
+// Compute returns the edit script turning a into b. Elements of the longest common
+// subsequence are kept as equal, deletions are placed before insertions.
+func Compute[T comparable](a []T, b []T) []Edit[T] {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit[T], 0, max(len(a), len(b)))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, Edit[T]{Operation: OperationEqual, Value: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, Edit[T]{Operation: OperationDelete, Value: a[i]})
+			i++
+		default:
+			edits = append(edits, Edit[T]{Operation: OperationInsert, Value: b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		edits = append(edits, Edit[T]{Operation: OperationDelete, Value: a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		edits = append(edits, Edit[T]{Operation: OperationInsert, Value: b[j]})
+	}
+
+	return edits
+}
+
+// CommonLength returns the length of the longest common subsequence of a and b. Unlike
+// Compute, it keeps only two rows of the table, so memory grows with len(b) alone.
+func CommonLength[T comparable](a []T, b []T) int {
+	// next[j] is the length of the longest common subsequence of a[i+1:] and b[j:].
+	next := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				curr[j] = next[j+1] + 1
+			} else {
+				curr[j] = max(next[j], curr[j+1])
+			}
+		}
+
+		next, curr = curr, next
+	}
+
+	return next[0]
+}
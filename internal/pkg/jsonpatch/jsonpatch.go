@@ -0,0 +1,419 @@
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	OperationAdd     = "add"
+	OperationRemove  = "remove"
+	OperationReplace = "replace"
+	OperationMove    = "move"
+	OperationCopy    = "copy"
+	OperationTest    = "test"
+)
+
+var (
+	// ErrInvalid is returned for patch documents that are not well formed.
+	ErrInvalid = errors.New("invalid patch")
+	// ErrConflict is returned when a patch can't be applied to the document, e.g. a path
+	// doesn't exist or a test operation fails.
+	ErrConflict = errors.New("patch conflict")
+)
+
+// Patcher is a patch document applied to a JSON document.
+type Patcher interface {
+	Apply(doc []byte) ([]byte, error)
+}
+
+// MergePatch is an RFC 7396 JSON merge patch: members set to null are removed, objects
+// are merged recursively and any other value replaces the target.
+type MergePatch json.RawMessage
+
+func (p MergePatch) Apply(doc []byte) ([]byte, error) {
+	patch, err := decode(p)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	target, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(target, patch))
+}
+
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any, len(patchObject))
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+// Operation is a single RFC 6902 JSON patch operation.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Patch is an RFC 6902 JSON patch, its operations are applied in order and the patch fails
+// as a whole if any of them fails.
+type Patch []Operation
+
+// DecodePatch parses a JSON patch document and checks that its operations are well formed.
+func DecodePatch(data []byte) (Patch, error) {
+	var patch Patch
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&patch); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	for i, operation := range patch {
+		if err := operation.check(); err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %w", ErrInvalid, i, err)
+		}
+	}
+
+	return patch, nil
+}
+
+func (o Operation) check() error {
+	if _, err := parsePointer(o.Path); err != nil {
+		return err
+	}
+
+	switch o.Op {
+	case OperationAdd, OperationReplace, OperationTest:
+		if o.Value == nil {
+			return fmt.Errorf("%s requires value", o.Op)
+		}
+	case OperationMove, OperationCopy:
+		if _, err := parsePointer(o.From); err != nil {
+			return err
+		}
+
+		if o.Op == OperationMove && strings.HasPrefix(o.Path+"/", o.From+"/") && o.Path != o.From {
+			return errors.New("move to a child of the source")
+		}
+	case OperationRemove:
+	default:
+		return fmt.Errorf("unknown operation %q", o.Op)
+	}
+
+	return nil
+}
+
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, operation := range p {
+		if err := operation.check(); err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %w", ErrInvalid, i, err)
+		}
+
+		target, err = operation.apply(target)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %w", ErrConflict, i, err)
+		}
+	}
+
+	return json.Marshal(target)
+}
+
+func (o Operation) apply(doc any) (any, error) {
+	path, _ := parsePointer(o.Path)
+
+	switch o.Op {
+	case OperationAdd:
+		value, err := decode(o.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, path, value)
+	case OperationRemove:
+		doc, _, err := remove(doc, path)
+
+		return doc, err
+	case OperationReplace:
+		value, err := decode(o.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(path) == 0 {
+			return value, nil
+		}
+
+		doc, _, err = remove(doc, path)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, path, value)
+	case OperationMove:
+		from, _ := parsePointer(o.From)
+
+		if o.From == o.Path {
+			_, err := get(doc, from)
+
+			return doc, err
+		}
+
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, path, value)
+	case OperationCopy:
+		from, _ := parsePointer(o.From)
+
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+
+		// Values are copied through JSON so the copy doesn't share maps and slices.
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if value, err = decode(data); err != nil {
+			return nil, err
+		}
+
+		return add(doc, path, value)
+	case OperationTest:
+		expected, err := decode(o.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			return nil, fmt.Errorf("test of %q failed", o.Path)
+		}
+
+		return doc, nil
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", o.Op)
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into its unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid path %q", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens, nil
+}
+
+func get(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+
+			doc = value
+		case []any:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			doc = node[index]
+		default:
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+	}
+
+	return doc, nil
+}
+
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	token := path[0]
+
+	switch node := doc.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			node[token] = value
+			return node, nil
+		}
+
+		child, ok := node[token]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+
+		child, err := add(child, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+
+		node[token] = child
+
+		return node, nil
+	case []any:
+		if len(path) == 1 {
+			if token == "-" {
+				return append(node, value), nil
+			}
+
+			index, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+
+			return append(node[:index], append([]any{value}, node[index:]...)...), nil
+		}
+
+		index, err := arrayIndex(token, len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		child, err := add(node[index], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+
+		node[index] = child
+
+		return node, nil
+	}
+
+	return nil, fmt.Errorf("member %q not found", token)
+}
+
+// remove deletes the value at path and returns the document along with the removed value.
+func remove(doc any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("can't remove the whole document")
+	}
+
+	token := path[0]
+
+	switch node := doc.(type) {
+	case map[string]any:
+		child, ok := node[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("member %q not found", token)
+		}
+
+		if len(path) == 1 {
+			delete(node, token)
+			return node, child, nil
+		}
+
+		child, removed, err := remove(child, path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		node[token] = child
+
+		return node, removed, nil
+	case []any:
+		index, err := arrayIndex(token, len(node)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(path) == 1 {
+			removed := node[index]
+			return append(node[:index], node[index+1:]...), removed, nil
+		}
+
+		child, removed, err := remove(node[index], path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		node[index] = child
+
+		return node, removed, nil
+	}
+
+	return nil, nil, fmt.Errorf("member %q not found", token)
+}
+
+// arrayIndex parses an array index token, which has to be at most maxIndex.
+func arrayIndex(token string, maxIndex int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > maxIndex {
+		return 0, fmt.Errorf("array index %q out of range", token)
+	}
+
+	return index, nil
+}
+
+func decode(data []byte) (any, error) {
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter spaces calls evenly so that no more than rate calls per second are made.
+// A non-positive rate disables limiting.
+type Limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func NewLimiter(rate float64) *Limiter {
+	var interval time.Duration
+
+	if rate > 0 {
+		interval = time.Duration(float64(time.Second) / rate)
+	}
+
+	return &Limiter{
+		interval: interval,
+	}
+}
+
+// Wait blocks until the next call is allowed or ctx is done. A call given up on
+// because of ctx still uses its slot.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l.interval <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Cache stores Music Service responses. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// CachePolicy sets how long found songs and songs missing upstream are cached.
+type CachePolicy struct {
+	TTL         time.Duration
+	NotFoundTTL time.Duration
+}
+
+type CacheStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+}
+
+// cachedSongInfo is a cached Info response: either the song details or the message
+// Music Service returned for a song it does not have.
+type cachedSongInfo struct {
+	SongInfo *SongInfo `json:"song_info,omitempty"`
+	NotFound string    `json:"not_found,omitempty"`
+}
+
+func (e cachedSongInfo) result() (*SongInfo, error) {
+	if e.SongInfo == nil {
+		return nil, &StatusError{Code: http.StatusNotFound, Message: e.NotFound}
+	}
+
+	return e.SongInfo, nil
+}
+
+func (c *MusicServiceClient) cacheKey(group string, song string) string {
+	query := url.Values{
+		"group": []string{group},
+		"song":  []string{song},
+	}
+
+	return c.name + "/info?" + query.Encode()
+}
+
+func (c *MusicServiceClient) cached(ctx context.Context, key string) (cachedSongInfo, bool) {
+	value, ok, err := c.cache.Get(ctx, key)
+	if err != nil {
+		c.logger.Warn("get music service response from cache failed", slog.String("error", err.Error()))
+
+		return cachedSongInfo{}, false
+	}
+
+	if !ok {
+		return cachedSongInfo{}, false
+	}
+
+	var entry cachedSongInfo
+	if err := json.Unmarshal(value, &entry); err != nil {
+		c.logger.Warn("decode cached music service response failed", slog.String("error", err.Error()))
+
+		return cachedSongInfo{}, false
+	}
+
+	return entry, true
+}
+
+// store caches found songs and songs missing upstream, other responses are not cached.
+func (c *MusicServiceClient) store(ctx context.Context, key string, songInfo *SongInfo, err error) {
+	var (
+		entry     cachedSongInfo
+		ttl       time.Duration
+		statusErr *StatusError
+	)
+
+	switch {
+	case err == nil:
+		entry.SongInfo = songInfo
+		ttl = c.cachePolicy.TTL
+	case errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound:
+		entry.NotFound = statusErr.Message
+		ttl = c.cachePolicy.NotFoundTTL
+	default:
+		return
+	}
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		c.logger.Warn("encode music service response failed", slog.String("error", err.Error()))
+
+		return
+	}
+
+	if err := c.cache.Set(ctx, key, value, ttl); err != nil {
+		c.logger.Warn("set music service response to cache failed", slog.String("error", err.Error()))
+	}
+}
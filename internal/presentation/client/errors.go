@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"song-service/internal/application/providers"
+)
+
+// StatusError is a Music Service response with a status other than 200 OK.
+type StatusError struct {
+	Code       int
+	Message    string
+	retryAfter string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("music service responded with %d: %s", e.Code, e.Message)
+}
+
+// Unwrap maps the status to the provider errors: 404 means the song is not found, and
+// other client errors except 429 mean the request is rejected.
+func (e *StatusError) Unwrap() error {
+	switch {
+	case e.Code == http.StatusNotFound:
+		return providers.ErrSongNotFound
+	case e.Code >= http.StatusBadRequest && e.Code < http.StatusInternalServerError && e.Code != http.StatusTooManyRequests:
+		return providers.ErrRejected
+	default:
+		return nil
+	}
+}
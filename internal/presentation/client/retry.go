@@ -0,0 +1,67 @@
+package client
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"song-service/internal/application/providers"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retries of failed Music Service requests. Attempts is the total
+// number of attempts, the delay before the n-th retry is Backoff * 2^(n-1) capped at
+// MaxBackoff, with up to half of it replaced by random jitter.
+type RetryPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	backoff := p.Backoff
+
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, p.MaxBackoff)
+
+	if backoff <= 1 {
+		return backoff
+	}
+
+	return backoff/2 + rand.N(backoff/2)
+}
+
+// retryable reports whether a failed request may succeed if repeated: transport errors,
+// timeouts, 429 and 5xx responses, but not malformed responses or song details.
+func retryable(err error) bool {
+	if errors.Is(err, providers.ErrInvalid) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == http.StatusTooManyRequests || statusErr.Code >= http.StatusInternalServerError
+	}
+
+	return err != nil
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
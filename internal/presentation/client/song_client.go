@@ -6,9 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"song-service/internal/application/providers"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+	"song-service/internal/pkg/breaker"
+	"sync/atomic"
+	"time"
 
 	"github.com/hardfinhq/go-date"
+	"golang.org/x/sync/singleflight"
 )
 
 type SongInfo struct {
@@ -17,50 +26,215 @@ type SongInfo struct {
 	Link        string    `json:"link"`
 }
 
+func (i SongInfo) model() models.SongInfo {
+	return models.SongInfo{
+		ReleaseDate: i.ReleaseDate,
+		Text:        i.Text,
+		Link:        i.Link,
+	}
+}
+
+// MusicServiceClient is a SongInfoProvider backed by a Music Service compatible API.
 type MusicServiceClient struct {
-	client  *http.Client
-	baseURL string
+	name        string
+	client      *http.Client
+	baseURL     string
+	retryPolicy RetryPolicy
+	breaker     *breaker.CircuitBreaker
+	cache       Cache
+	cachePolicy CachePolicy
+	logger      *slog.Logger
+
+	requests singleflight.Group
+	hits     atomic.Int64
+	misses   atomic.Int64
 }
 
-func NewMusicServiceClient(client *http.Client, baseURL string) *MusicServiceClient {
+// NewMusicServiceClient creates a client. If cache is nil, responses are not cached.
+func NewMusicServiceClient(name string, client *http.Client, baseURL string, retryPolicy RetryPolicy, breaker *breaker.CircuitBreaker, cache Cache, cachePolicy CachePolicy, logger *slog.Logger) *MusicServiceClient {
 	return &MusicServiceClient{
-		client:  client,
-		baseURL: baseURL,
+		name:        name,
+		client:      client,
+		baseURL:     baseURL,
+		retryPolicy: retryPolicy,
+		breaker:     breaker,
+		cache:       cache,
+		cachePolicy: cachePolicy,
+		logger:      logger,
+	}
+}
+
+// Info returns song details. Responses other than 200 OK are returned as *StatusError,
+// malformed details as an error wrapping providers.ErrInvalid.
+func (c *MusicServiceClient) Info(ctx context.Context, group string, song string) (models.SongInfo, error) {
+	songInfo, err := c.lookup(ctx, group, song)
+	if err != nil {
+		return models.SongInfo{}, err
+	}
+
+	return songInfo.model(), nil
+}
+
+func (c *MusicServiceClient) Name() string {
+	return c.name
+}
+
+// CacheStats returns the number of cache hits and misses since the client was created.
+func (c *MusicServiceClient) CacheStats() CacheStats {
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// BreakerState returns the state of the circuit breaker guarding Music Service.
+func (c *MusicServiceClient) BreakerState() breaker.State {
+	return c.breaker.State()
+}
+
+// lookup serves song details from the cache when possible. Songs missing upstream are
+// cached too, for a shorter time. Concurrent lookups of the same song share a single
+// request, which is not cancelled when one of the callers gives up.
+func (c *MusicServiceClient) lookup(ctx context.Context, group string, song string) (*SongInfo, error) {
+	if c.cache == nil {
+		return c.fetch(ctx, group, song)
+	}
+
+	key := c.cacheKey(group, song)
+
+	if entry, ok := c.cached(ctx, key); ok {
+		c.hits.Add(1)
+
+		return entry.result()
+	}
+
+	c.misses.Add(1)
+
+	results := c.requests.DoChan(key, func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+
+		songInfo, err := c.fetch(ctx, group, song)
+		c.store(ctx, key, songInfo, err)
+
+		return songInfo, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		return result.Val.(*SongInfo), result.Err
+	}
+}
+
+// fetch requests song details, retrying transport errors, timeouts, 429 and 5xx responses
+// with exponential backoff. A Retry-After header longer than the maximum backoff stops
+// retries. Malformed responses wrap providers.ErrInvalid and are neither retried nor
+// counted by the circuit breaker. Once the upstream keeps failing the circuit breaker
+// opens and fetch returns an error wrapping providers.ErrUnavailable and breaker.ErrOpen
+// without making a request.
+func (c *MusicServiceClient) fetch(ctx context.Context, group string, song string) (*SongInfo, error) {
+	for attempt := 1; ; attempt++ {
+		if err := c.breaker.Allow(); err != nil {
+			return nil, fmt.Errorf("%w: %w", providers.ErrUnavailable, err)
+		}
+
+		songInfo, err := c.info(ctx, group, song)
+
+		// The caller gave up, which says nothing about the upstream health.
+		if ctx.Err() != nil {
+			c.breaker.Ignore()
+
+			return nil, err
+		}
+
+		// Malformed song details are not a sign of the upstream failing, nor of it recovering.
+		if errors.Is(err, providers.ErrInvalid) {
+			c.breaker.Ignore()
+
+			return nil, err
+		}
+
+		if !retryable(err) {
+			c.breaker.Success()
+
+			return songInfo, err
+		}
+
+		c.breaker.Failure()
+
+		if attempt >= c.retryPolicy.Attempts {
+			return nil, err
+		}
+
+		delay := c.retryPolicy.delay(attempt)
+
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			if wait, ok := parseRetryAfter(statusErr.retryAfter); ok {
+				if wait > c.retryPolicy.MaxBackoff {
+					return nil, err
+				}
+
+				delay = max(delay, wait)
+			}
+		}
+
+		c.logger.Debug("retry music service request",
+			slog.String("name", c.name),
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.String("error", err.Error()),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
 	}
 }
 
-func (c MusicServiceClient) Info(ctx context.Context, group string, song string) (*SongInfo, int, error) {
-	url := fmt.Sprintf("%s/info?group=%s&song=%s", c.baseURL, group, song)
+func (c *MusicServiceClient) info(ctx context.Context, group string, song string) (*SongInfo, error) {
+	query := url.Values{
+		"group": []string{group},
+		"song":  []string{song},
+	}
+
+	url := fmt.Sprintf("%s/info?%s", c.baseURL, query.Encode())
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, resp.StatusCode, err
-		}
-
-		return nil, resp.StatusCode, errors.New(string(body))
-	}
-
 	bytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{
+			Code:       resp.StatusCode,
+			Message:    string(bytes),
+			retryAfter: resp.Header.Get("Retry-After"),
+		}
 	}
 
 	var songInfo SongInfo
 	if err := json.Unmarshal(bytes, &songInfo); err != nil {
-		return nil, 0, err
+		return nil, fmt.Errorf("%w: decode response: %w", providers.ErrInvalid, err)
+	}
+
+	if err := validation.ValidateSongInfo(songInfo.model()); err != nil {
+		return nil, fmt.Errorf("%w: %w", providers.ErrInvalid, err)
 	}
 
-	return &songInfo, resp.StatusCode, nil
+	return &songInfo, nil
 }
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"log/slog"
+	"song-service/internal/application/services"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AdminHandler struct {
+	purgeService *services.PurgeService
+	logger       *slog.Logger
+	tracer       trace.Tracer
+}
+
+func NewAdminHandler(purgeService *services.PurgeService, logger *slog.Logger, tracer trace.Tracer) *AdminHandler {
+	return &AdminHandler{
+		purgeService: purgeService,
+		logger:       logger,
+		tracer:       tracer,
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateGroupRequest struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type CreateGroupResponse struct {
+	Group models.Group `json:"group"`
+}
+
+// CreateGroup godoc
+// @Summary      Create group
+// @Description  Добавление группы в библиотеку
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        request body     CreateGroupRequest  true  "Group details"
+// @Success      200    {object}  CreateGroupResponse
+// @Failure      400    {object}  Problem             "Invalid input data"
+// @Failure      409    {object}  Problem             "Group already exists"
+// @Failure      500    {object}  Problem             "Internal Server Error"
+// @Router       /groups [post]
+func (h *GroupHandler) CreateGroup(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.CreateGroup")
+	defer span.End()
+
+	var request CreateGroupRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	group := models.Group{
+		Name: request.Name,
+	}
+
+	createdGroup, err := h.groupService.CreateGroup(ctx, group)
+	if err != nil {
+		writeError(c, h.logger, "failed to create group", err)
+		return
+	}
+
+	response := CreateGroupResponse{
+		Group: createdGroup,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
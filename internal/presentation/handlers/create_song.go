@@ -1,79 +1,167 @@
 package handlers
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
-	repo "song-service/internal/application/repository"
 	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
 
 	"github.com/gin-gonic/gin"
+	"github.com/hardfinhq/go-date"
 )
 
+const (
+	EnrichMissing = "missing"
+	EnrichNone    = "none"
+)
+
+// CreateSongRequest is a song to add. Release date, text and link are either all set,
+// or all omitted to fetch them from the song info providers, unless enrich=missing is used.
+// The language of the text is never fetched and may be omitted.
 type CreateSongRequest struct {
-	Group string `json:"group" binding:"required"`
-	Song  string `json:"song"  binding:"required"`
+	Group       string     `json:"group"        binding:"required"`
+	Song        string     `json:"song"         binding:"required"`
+	ReleaseDate *date.Date `json:"release_date" swaggertype:"primitive,string"`
+	Text        *string    `json:"text"`
+	Link        *string    `json:"link"`
+	Language    string     `json:"language"     example:"en"`
+}
+
+func (r CreateSongRequest) HasDetails() bool {
+	return r.ReleaseDate != nil && r.Text != nil && r.Link != nil
+}
+
+func (r CreateSongRequest) HasAnyDetails() bool {
+	return r.ReleaseDate != nil || r.Text != nil || r.Link != nil
+}
+
+type CreateSongQueryParams struct {
+	Async  bool   `form:"async"`
+	Enrich string `form:"enrich" binding:"omitempty,oneof=missing none"`
 }
 
 type CreateSongResponse struct {
 	Song models.Song `json:"song"`
 }
 
+type CreateSongJobResponse struct {
+	Job models.SongJob `json:"job"`
+}
+
 // CreateSong godoc
-// @Summary      Add song from Music Service
-// @Description  Добавление песни в библиотеку из Music Service
+// @Summary      Add song
+// @Description  Добавление песни в библиотеку. Если дата выпуска, текст и ссылка не указаны, они загружаются из поставщиков данных о песнях, если указаны все, песня добавляется как есть. С enrich=missing из поставщиков загружаются только неуказанные поля, с enrich=none поставщики не опрашиваются. С параметром async песня добавляется в фоне, а в ответ возвращается задача, статус которой можно получить через /jobs/{id}
 // @Tags         songs
 // @Accept       json
 // @Produce      json
 // @Param        request body     CreateSongRequest  true  "Song details"
+// @Param        async   query    bool               false "Add song in background, only without song details" default(false)
+// @Param        enrich  query    string             false "Fetch only missing song details or none of them" Enums(missing, none)
 // @Success      200    {object}  CreateSongResponse
-// @Failure      400    {string}  string             "Invalid input data"
-// @Failure      409    {string}  string             "Song already exists"
-// @Failure      500    {string}  string             "Internal Server Error"
+// @Header       200    {string}  ETag               "Song version"
+// @Success      202    {object}  CreateSongJobResponse
+// @Header       202    {string}  Location           "Job URL"
+// @Failure      400    {object}  Problem            "Invalid input data"
+// @Failure      404    {object}  Problem            "Song not found by song info providers"
+// @Failure      409    {object}  Problem            "Song already exists"
+// @Failure      422    {object}  Problem            "Invalid song fields"
+// @Failure      500    {object}  Problem            "Internal Server Error"
+// @Failure      502    {object}  Problem            "Song info providers request failed"
+// @Failure      503    {object}  Problem            "Song info providers are unavailable"
 // @Router       /songs [post]
 func (h *SongHandler) CreateSong(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.CreateSong")
 	defer span.End()
 
+	var queryParams CreateSongQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
 	var request CreateSongRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
 		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
 
-		c.String(http.StatusBadRequest, err.Error())
+		writeBindingError(c, err)
 		return
 	}
 
-	songInfo, code, err := h.client.Info(ctx, request.Group, request.Song)
-	if err != nil {
-		h.logger.Warn("failed to get song info from music service", slog.String("error", err.Error()))
+	if queryParams.Async {
+		if request.HasAnyDetails() || request.Language != "" || queryParams.Enrich != "" {
+			writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "async mode does not accept song details")
+			return
+		}
 
-		if code != 0 {
-			c.String(code, err.Error())
+		job, err := h.songJobService.EnqueueSong(ctx, request.Group, request.Song)
+		if err != nil {
+			var validationErr *validation.Error
+
+			// Only the request parameters are validated before the job is queued.
+			if errors.As(err, &validationErr) {
+				writeProblemWithErrors(c, http.StatusBadRequest, ProblemInvalidRequest, "Request parameters are invalid", validationErr.Fields)
+				return
+			}
+
+			writeError(c, h.logger, "failed to enqueue song job", err)
 			return
 		}
 
-		c.Status(http.StatusInternalServerError)
+		response := CreateSongJobResponse{
+			Job: job,
+		}
+
+		c.Header(headerLocation, fmt.Sprintf("/jobs/%s", job.ID.String()))
+		c.JSON(http.StatusAccepted, response)
 		return
 	}
 
 	song := models.Song{
-		Name:        request.Song,
-		Group:       request.Group,
-		ReleaseDate: songInfo.ReleaseDate,
-		Text:        songInfo.Text,
-		Link:        songInfo.Link,
+		Name:     request.Song,
+		Group:    request.Group,
+		Language: request.Language,
 	}
 
-	createdSong, err := h.songService.CreateSong(ctx, song)
-	if err != nil {
-		if errors.Is(err, repo.ErrDuplicate) {
-			c.String(http.StatusConflict, err.Error())
+	if request.ReleaseDate != nil {
+		song.ReleaseDate = *request.ReleaseDate
+	}
+
+	if request.Text != nil {
+		song.Text = *request.Text
+	}
+
+	if request.Link != nil {
+		song.Link = *request.Link
+	}
+
+	switch {
+	case request.HasDetails():
+	case queryParams.Enrich == EnrichNone:
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "release_date, text and link are required with enrich=none")
+		return
+	case queryParams.Enrich == "" && request.HasAnyDetails():
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "release_date, text and link are required, use enrich=missing to fetch the missing ones")
+		return
+	default:
+		songInfo, err := h.songInfoProvider.Info(ctx, request.Group, request.Song)
+		if err != nil {
+			writeSongInfoError(c, h.logger, err)
 			return
 		}
 
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
+		song.ReleaseDate = cmp.Or(song.ReleaseDate, songInfo.ReleaseDate)
+		song.Text = cmp.Or(song.Text, songInfo.Text)
+		song.Link = cmp.Or(song.Link, songInfo.Link)
+	}
 
-		c.Status(http.StatusInternalServerError)
+	createdSong, err := h.songService.CreateSong(ctx, song)
+	if err != nil {
+		writeError(c, h.logger, "failed to create song", err)
 		return
 	}
 
@@ -81,5 +169,6 @@ func (h *SongHandler) CreateSong(c *gin.Context) {
 		Song: createdSong,
 	}
 
+	c.Header(headerETag, songETag(createdSong))
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"song-service/internal/application/providers"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+	"song-service/internal/domain/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hardfinhq/go-date"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	songBatchConcurrency = 8
+)
+
+const (
+	SongBatchStatusCreated   = "created"
+	SongBatchStatusDuplicate = "duplicate"
+	SongBatchStatusNotFound  = "not_found"
+	SongBatchStatusInvalid   = "invalid"
+	SongBatchStatusFailed    = "failed"
+	SongBatchStatusSkipped   = "skipped"
+)
+
+// CreateSongBatchItem is a song to add. If release date, text and link are all set,
+// the song is created as is, otherwise the missing details are fetched from the song info
+// providers.
+// The language of the text is never fetched and may be omitted.
+type CreateSongBatchItem struct {
+	Group       string     `json:"group"        binding:"required"`
+	Song        string     `json:"song"         binding:"required"`
+	ReleaseDate *date.Date `json:"release_date" swaggertype:"primitive,string"`
+	Text        *string    `json:"text"`
+	Link        *string    `json:"link"`
+	Language    string     `json:"language"     example:"en"`
+}
+
+func (i CreateSongBatchItem) HasDetails() bool {
+	return i.ReleaseDate != nil && i.Text != nil && i.Link != nil
+}
+
+type CreateSongBatchRequest struct {
+	Songs []CreateSongBatchItem `json:"songs" binding:"required,min=1,max=100,dive"`
+}
+
+type CreateSongBatchQueryParams struct {
+	Atomic bool `form:"atomic"`
+}
+
+// CreateSongBatchResult is the outcome of adding a song of the batch. Error is the problem
+// code of a failed song, the violations of an invalid song are listed separately.
+type CreateSongBatchResult struct {
+	Index      int                     `json:"index"`
+	Group      string                  `json:"group"`
+	Song       string                  `json:"song"`
+	Status     string                  `json:"status"`
+	ID         *uuid.UUID              `json:"id,omitempty"`
+	Error      string                  `json:"error,omitempty"      example:"upstream_unavailable"`
+	Violations []validation.FieldError `json:"violations,omitempty"`
+}
+
+type CreateSongBatchResponse struct {
+	Committed bool                    `json:"committed"`
+	Results   []CreateSongBatchResult `json:"results"`
+}
+
+// CreateSongBatch godoc
+// @Summary      Add songs in batch
+// @Description  Пакетное добавление до 100 песен, недостающие детали песен загружаются из поставщиков данных о песнях. Для каждой песни возвращается статус: created, duplicate, not_found, invalid, failed или skipped
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        request  body     CreateSongBatchRequest  true   "Songs to add"
+// @Param        atomic   query    bool                    false  "Create all songs or none of them" default(false)
+// @Success      200      {object} CreateSongBatchResponse
+// @Failure      400      {object} Problem                 "Invalid input data"
+// @Failure      500      {object} Problem                 "Internal Server Error"
+// @Router       /songs/batch [post]
+func (h *SongHandler) CreateSongBatch(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.CreateSongBatch")
+	defer span.End()
+
+	var queryParams CreateSongBatchQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	var request CreateSongBatchRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	songList, results := h.fetchSongBatch(ctx, request.Songs)
+
+	var (
+		pending = make([]int, 0, len(songList))
+		failed  bool
+	)
+
+	for i := range results {
+		if results[i].Status == "" {
+			pending = append(pending, i)
+		} else {
+			failed = true
+		}
+	}
+
+	response := CreateSongBatchResponse{
+		Results: results,
+	}
+
+	if len(pending) == 0 || (queryParams.Atomic && failed) {
+		for _, i := range pending {
+			response.Results[i].Status = SongBatchStatusSkipped
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	pendingSongList := make([]models.Song, 0, len(pending))
+	for _, i := range pending {
+		pendingSongList = append(pendingSongList, songList[i])
+	}
+
+	batchResult, err := h.songService.CreateSongBatch(ctx, pendingSongList, queryParams.Atomic)
+	if err != nil {
+		writeError(c, h.logger, "failed to create song batch", err)
+		return
+	}
+
+	response.Committed = batchResult.Committed
+
+	for j, i := range pending {
+		result := batchResult.Results[j]
+
+		var validationErr *validation.Error
+
+		switch {
+		case result.Err == nil && !batchResult.Committed:
+			response.Results[i].Status = SongBatchStatusSkipped
+		case result.Err == nil:
+			response.Results[i].Status = SongBatchStatusCreated
+			response.Results[i].ID = &result.Song.ID
+		case errors.As(result.Err, &validationErr):
+			response.Results[i].Status = SongBatchStatusInvalid
+			response.Results[i].Error = errorCode(result.Err)
+			response.Results[i].Violations = validationErr.Fields
+		case errors.Is(result.Err, repo.ErrDuplicate):
+			response.Results[i].Status = SongBatchStatusDuplicate
+			response.Results[i].Error = errorCode(result.Err)
+		default:
+			h.logger.Warn("failed to create song", slog.Int("index", i), slog.String("error", result.Err.Error()))
+
+			response.Results[i].Status = SongBatchStatusFailed
+			response.Results[i].Error = errorCode(result.Err)
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// fetchSongBatch builds songs from the batch items, fetching the missing details of songs
+// from the song info providers with bounded concurrency. Results of songs that could not be fetched
+// already have their status set.
+func (h *SongHandler) fetchSongBatch(ctx context.Context, items []CreateSongBatchItem) ([]models.Song, []CreateSongBatchResult) {
+	var (
+		songList = make([]models.Song, len(items))
+		results  = make([]CreateSongBatchResult, len(items))
+	)
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(songBatchConcurrency)
+
+	for i, item := range items {
+		songList[i] = models.Song{
+			Name:     item.Song,
+			Group:    item.Group,
+			Language: item.Language,
+		}
+
+		results[i] = CreateSongBatchResult{
+			Index: i,
+			Group: item.Group,
+			Song:  item.Song,
+		}
+
+		if item.ReleaseDate != nil {
+			songList[i].ReleaseDate = *item.ReleaseDate
+		}
+
+		if item.Text != nil {
+			songList[i].Text = *item.Text
+		}
+
+		if item.Link != nil {
+			songList[i].Link = *item.Link
+		}
+
+		if item.HasDetails() {
+			continue
+		}
+
+		group.Go(func() error {
+			songInfo, err := h.songInfoProvider.Info(ctx, item.Group, item.Song)
+			if err != nil {
+				h.logger.Warn("failed to get song info", slog.String("error", err.Error()))
+
+				results[i].Status = SongBatchStatusFailed
+				if errors.Is(err, providers.ErrSongNotFound) {
+					results[i].Status = SongBatchStatusNotFound
+				}
+
+				results[i].Error = songInfoErrorCode(err)
+
+				return nil
+			}
+
+			songList[i].ReleaseDate = cmp.Or(songList[i].ReleaseDate, songInfo.ReleaseDate)
+			songList[i].Text = cmp.Or(songList[i].Text, songInfo.Text)
+			songList[i].Link = cmp.Or(songList[i].Link, songInfo.Link)
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	return songList, results
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DeleteGroupResponse struct {
+	DeletedTime time.Time `json:"deleted_time"`
+}
+
+// DeleteGroup godoc
+// @Summary      Delete group by ID
+// @Description  Удаление группы по ID. Песни группы скрываются вместе с ней
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Group ID"
+// @Success      200    {object} DeleteGroupResponse
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Group not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /groups/{id} [delete]
+func (h *GroupHandler) DeleteGroup(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.DeleteGroup")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	deletedTime, err := h.groupService.DeleteGroup(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to delete group", err)
+		return
+	}
+
+	response := DeleteGroupResponse{
+		DeletedTime: *deletedTime,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
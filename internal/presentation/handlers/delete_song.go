@@ -1,10 +1,7 @@
 package handlers
 
 import (
-	"errors"
-	"log/slog"
 	"net/http"
-	repo "song-service/internal/application/repository"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -23,9 +20,9 @@ type DeleteSongResponse struct {
 // @Produce      json
 // @Param        id     path     string  true  "Song ID"
 // @Success      200    {object} DeleteSongResponse
-// @Failure      400    {string} string  "Invalid ID format"
-// @Failure      404    {string} string  "Song not found"
-// @Failure      500    {string} string  "Internal Server Error"
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Song not found"
+// @Failure      500    {object} Problem "Internal Server Error"
 // @Router       /songs/{id} [delete]
 func (h *SongHandler) DeleteSong(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.DeleteSong")
@@ -33,20 +30,13 @@ func (h *SongHandler) DeleteSong(c *gin.Context) {
 
 	id, err := uuid.Parse(c.Param(pathParamID))
 	if err != nil {
-		c.String(http.StatusBadRequest, "Invalid ID format")
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
 		return
 	}
 
 	deletedTime, err := h.songService.DeleteSong(ctx, id)
 	if err != nil {
-		if errors.Is(err, repo.ErrObjectNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-			return
-		}
-
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
-
-		c.Status(http.StatusInternalServerError)
+		writeError(c, h.logger, "failed to delete song", err)
 		return
 	}
 
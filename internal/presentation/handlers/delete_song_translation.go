@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DeleteSongTranslationResponse struct {
+	Translation models.SongTranslation `json:"translation"`
+}
+
+// DeleteSongTranslation godoc
+// @Summary      Delete song translation
+// @Description  Удаление перевода текста песни, в ответе возвращается удаленный перевод. Удаление перевода меняет версию песни
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string  true   "Song ID"
+// @Param        lang     path     string  true   "Language tag" example(de)
+// @Param        If-Match header   string  false  "ETag of the song version to update"
+// @Success      200      {object} DeleteSongTranslationResponse
+// @Header       200      {string} ETag    "Song version"
+// @Failure      400      {object} Problem "Invalid ID format or language"
+// @Failure      404      {object} Problem "Song or translation not found"
+// @Failure      412      {object} Problem "Song version does not match If-Match"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/translations/{lang} [delete]
+func (h *SongHandler) DeleteSongTranslation(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.DeleteSongTranslation")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	language, ok := canonicalLanguage(c, c.Param(pathParamLanguage))
+	if !ok {
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
+		return
+	}
+
+	updatedSong, deletedTranslation, err := h.songService.DeleteSongTranslation(ctx, id, version, language)
+	if err != nil {
+		writeError(c, h.logger, "failed to delete song translation", err)
+		return
+	}
+
+	response := DeleteSongTranslationResponse{
+		Translation: deletedTranslation,
+	}
+
+	c.Header(headerETag, songETag(updatedSong))
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeletedSongListQueryParams struct {
+	repo.Pagination
+}
+
+type DeletedSongListResponse struct {
+	SongList []models.DeletedSong `json:"song_list"`
+}
+
+// DeletedSongList godoc
+// @Summary      Get deleted songs list
+// @Description  Получение списка удалённых песен, начиная с последних удалённых
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        limit    query    int     false  "Limit of songs"        default(10) maximum(1000)
+// @Param        offset   query    int     false  "Offset for pagination" default(0)
+// @Success      200      {object} DeletedSongListResponse
+// @Failure      400      {object} Problem "Invalid query parameters"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /songs/trash [get]
+func (h *SongHandler) DeletedSongList(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.DeletedSongList")
+	defer span.End()
+
+	var queryParams DeletedSongListQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	songList, err := h.songService.DeletedSongList(ctx, &queryParams.Pagination)
+	if err != nil {
+		writeError(c, h.logger, "failed to get deleted song list", err)
+		return
+	}
+
+	response := DeletedSongListResponse{
+		SongList: songList,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
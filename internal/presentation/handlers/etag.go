@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"song-service/internal/domain/models"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+func songETag(song models.Song) string {
+	return fmt.Sprintf(`"%d"`, song.Version)
+}
+
+// songLanguageETag returns the ETag of the song text in the given language, so that
+// caches tell the translations of the same version apart. The original text has the
+// plain version tag.
+func songLanguageETag(song models.Song, language string) string {
+	if language == "" {
+		return songETag(song)
+	}
+
+	return fmt.Sprintf(`"%d-%s"`, song.Version, language)
+}
+
+// matchETag reports whether etag is listed in an If-Match or If-None-Match header value.
+// Weak tags only match with weak comparison, which is used for If-None-Match.
+func matchETag(header string, etag string, weak bool) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" {
+			return true
+		}
+
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+
+		if tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkIfMatch evaluates the If-Match header against the current song and returns the
+// version the update has to be applied to, or 0 when the header is absent.
+// On failure the response is already written.
+func (h *SongHandler) checkIfMatch(ctx context.Context, c *gin.Context, id uuid.UUID) (int32, bool) {
+	header := c.GetHeader(headerIfMatch)
+	if header == "" {
+		return 0, true
+	}
+
+	song, err := h.songService.Song(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song", err)
+		return 0, false
+	}
+
+	if !matchVersionETag(header, songETag(song)) {
+		writeProblem(c, http.StatusPreconditionFailed, ProblemVersionMismatch, "Song version does not match If-Match header")
+		return 0, false
+	}
+
+	return song.Version, true
+}
+
+// matchVersionETag reports whether the version of a song ETag listed in an If-Match header
+// is the given one. The language of a translation tag is ignored, since every language of
+// the song shares the version.
+func matchVersionETag(header string, etag string) bool {
+	tags := strings.Split(header, ",")
+
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+
+		if version, _, found := strings.Cut(tag, "-"); found && !strings.HasPrefix(tag, "W/") {
+			tag = version + `"`
+		}
+
+		tags[i] = tag
+	}
+
+	return matchETag(strings.Join(tags, ","), etag, false)
+}
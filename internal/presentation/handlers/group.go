@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type GroupResponse struct {
+	Group models.Group `json:"group"`
+}
+
+// Group godoc
+// @Summary      Get group
+// @Description  Получение группы по ID
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string  true   "Group ID"
+// @Success      200      {object} GroupResponse
+// @Failure      400      {object} Problem "Invalid ID format"
+// @Failure      404      {object} Problem "Group not found"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /groups/{id} [get]
+func (h *GroupHandler) Group(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.Group")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	group, err := h.groupService.Group(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get group", err)
+		return
+	}
+
+	response := GroupResponse{
+		Group: group,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"log/slog"
+	"song-service/internal/application/services"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GroupHandler struct {
+	groupService *services.GroupService
+	logger       *slog.Logger
+	tracer       trace.Tracer
+}
+
+func NewGroupHandler(groupService *services.GroupService, logger *slog.Logger, tracer trace.Tracer) *GroupHandler {
+	return &GroupHandler{
+		groupService: groupService,
+		logger:       logger,
+		tracer:       tracer,
+	}
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GroupListQueryParams struct {
+	repo.GroupFilter
+	repo.Pagination
+}
+
+type GroupListResponse struct {
+	GroupList []models.Group `json:"group_list"`
+}
+
+// GroupList godoc
+// @Summary      Get groups list
+// @Description  Получение списка групп с фильтрацией по названию и пагинацией
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        name     query    string  false  "Group name"
+// @Param        limit    query    int     false  "Limit of groups"       default(10) maximum(1000)
+// @Param        offset   query    int     false  "Offset for pagination" default(0)
+// @Success      200      {object} GroupListResponse
+// @Failure      400      {object} Problem "Invalid query parameters"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /groups [get]
+func (h *GroupHandler) GroupList(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.GroupList")
+	defer span.End()
+
+	var queryParams GroupListQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	groupList, err := h.groupService.GroupList(ctx, &queryParams.GroupFilter, &queryParams.Pagination)
+	if err != nil {
+		writeError(c, h.logger, "failed to get group list", err)
+		return
+	}
+
+	response := GroupListResponse{
+		GroupList: groupList,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/pkg/breaker"
+	"song-service/internal/presentation/client"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+)
+
+type ProviderHealth struct {
+	Name           string            `json:"name"`
+	CircuitBreaker breaker.State     `json:"circuit_breaker" swaggertype:"string" enums:"closed,open,half-open"`
+	Cache          client.CacheStats `json:"cache"`
+}
+
+type HealthResponse struct {
+	Status    string           `json:"status"    enums:"ok,degraded"`
+	Providers []ProviderHealth `json:"providers"`
+}
+
+// Health godoc
+// @Summary      Health check
+// @Description  Проверка состояния сервиса. Статус degraded означает, что один из поставщиков данных о песнях недоступен и его circuit breaker не закрыт. Для каждого поставщика также возвращается число попаданий и промахов кэша
+// @Tags         health
+// @Produce      json
+// @Success      200    {object} HealthResponse
+// @Router       /health [get]
+func (h *HealthHandler) Health(c *gin.Context) {
+	_, span := h.tracer.Start(c.Request.Context(), "HealthHandler.Health")
+	defer span.End()
+
+	response := HealthResponse{
+		Status:    HealthStatusOK,
+		Providers: make([]ProviderHealth, 0, len(h.clients)),
+	}
+
+	for _, providerClient := range h.clients {
+		state := providerClient.BreakerState()
+
+		if state != breaker.StateClosed {
+			response.Status = HealthStatusDegraded
+		}
+
+		response.Providers = append(response.Providers, ProviderHealth{
+			Name:           providerClient.Name(),
+			CircuitBreaker: state,
+			Cache:          providerClient.CacheStats(),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"log/slog"
+	"song-service/internal/presentation/client"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type HealthHandler struct {
+	clients []*client.MusicServiceClient
+	logger  *slog.Logger
+	tracer  trace.Tracer
+}
+
+func NewHealthHandler(clients []*client.MusicServiceClient, logger *slog.Logger, tracer trace.Tracer) *HealthHandler {
+	return &HealthHandler{
+		clients: clients,
+		logger:  logger,
+		tracer:  tracer,
+	}
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"log/slog"
+	"song-service/internal/application/services"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type JobHandler struct {
+	songJobService *services.SongJobService
+	logger         *slog.Logger
+	tracer         trace.Tracer
+}
+
+func NewJobHandler(songJobService *services.SongJobService, logger *slog.Logger, tracer trace.Tracer) *JobHandler {
+	return &JobHandler{
+		songJobService: songJobService,
+		logger:         logger,
+		tracer:         tracer,
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/lyrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	pathParamLanguage = "lang"
+
+	headerAcceptLanguage  = "Accept-Language"
+	headerContentLanguage = "Content-Language"
+)
+
+// LanguageParams selects the translation a song is served in. The lang parameter takes
+// precedence over the Accept-Language header.
+type LanguageParams struct {
+	Lang string `form:"lang"`
+}
+
+// languagePreference returns the language the client prefers the song in, the lang
+// parameter in canonical form or the Accept-Language header. The response varies by the
+// header either way. On failure the response is already written.
+func languagePreference(c *gin.Context, params LanguageParams) (string, bool) {
+	c.Header(headerVary, headerAcceptLanguage)
+
+	if params.Lang == "" {
+		return c.GetHeader(headerAcceptLanguage), true
+	}
+
+	language, ok := canonicalLanguage(c, params.Lang)
+	if !ok {
+		return "", false
+	}
+
+	return language, true
+}
+
+// canonicalLanguage returns the canonical form of a language tag given in the request.
+// On failure the response is already written.
+func canonicalLanguage(c *gin.Context, tag string) (string, bool) {
+	language, err := lyrics.CanonicalLanguage(tag)
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid language tag, use a BCP 47 tag such as de or pt-BR")
+		return "", false
+	}
+
+	return language, true
+}
+
+// canonicalLanguages replaces the language tags of a filter with their canonical form.
+// On failure the response is already written.
+func canonicalLanguages(c *gin.Context, tags []string) bool {
+	for i, tag := range tags {
+		language, ok := canonicalLanguage(c, tag)
+		if !ok {
+			return false
+		}
+
+		tags[i] = language
+	}
+
+	return true
+}
+
+// setContentLanguage reports the language of a translation the song is served in.
+func setContentLanguage(c *gin.Context, language string) {
+	if language != "" {
+		c.Header(headerContentLanguage, language)
+	}
+}
@@ -1,23 +1,34 @@
 package handlers
 
 import (
-	"errors"
+	"encoding/json"
 	"log/slog"
 	"net/http"
-	repo "song-service/internal/application/repository"
 	"song-service/internal/domain/models"
+	"song-service/internal/pkg/jsonpatch"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
 	"github.com/hardfinhq/go-date"
 )
 
+const (
+	contentTypeMergePatch = "application/merge-patch+json"
+	contentTypeJSONPatch  = "application/json-patch+json"
+)
+
+// PartialUpdateSongRequest is a merge patch of a song: omitted fields are left unchanged and
+// fields set to null are cleared, which unlike PUT is allowed for the text and link. With
+// application/json-patch+json the body is a list of JSON patch operations on the same fields
+// instead.
 type PartialUpdateSongRequest struct {
-	Name        string    `json:"song"`
-	Group       string    `json:"group"`
-	ReleaseDate date.Date `json:"release_date" swaggertype:"primitive,string"`
-	Text        string    `json:"text"`
-	Link        string    `json:"link"`
+	Name        *string    `json:"song,omitempty"`
+	Group       *string    `json:"group,omitempty"`
+	ReleaseDate *date.Date `json:"release_date,omitempty" swaggertype:"primitive,string"`
+	Text        *string    `json:"text,omitempty"`
+	Link        *string    `json:"link,omitempty"`
+	Language    *string    `json:"language,omitempty"     example:"en"`
 }
 
 type PartialUpdateSongResponse struct {
@@ -26,17 +37,22 @@ type PartialUpdateSongResponse struct {
 
 // PartialUpdateSong godoc
 // @Summary      Partially update song by ID
-// @Description  Частичное обновление информации о песне в библиотеке
+// @Description  Частичное обновление информации о песне в библиотеке. Тело запроса с типом application/merge-patch+json (или application/json) применяется как JSON Merge Patch (RFC 7396): поля со значением null очищаются. С типом application/json-patch+json тело является списком операций JSON Patch (RFC 6902). Изменения применяются к сохранённой песне в одной транзакции
 // @Tags         songs
-// @Accept       json
+// @Accept       json,application/merge-patch+json,application/json-patch+json
 // @Produce      json
 // @Param        id       path     string                     true  "Song ID"
-// @Param        request  body     PartialUpdateSongRequest   true  "Song details to be updated"
+// @Param        request  body     PartialUpdateSongRequest   true  "Merge patch or JSON patch of the song"
+// @Param        If-Match header   string                     false "ETag of the song version to update"
 // @Success      200      {object} PartialUpdateSongResponse
-// @Failure      400      {string} string                    "Invalid input data"
-// @Failure      404      {string} string                    "Song not found"
-// @Failure      409      {string} string                    "Name conflict"
-// @Failure      500      {string} string                    "Internal Server Error"
+// @Header       200      {string} ETag                      "Song version"
+// @Failure      400      {object} Problem                   "Invalid patch document"
+// @Failure      404      {object} Problem                   "Song not found"
+// @Failure      409      {object} Problem                   "Name conflict or patch can't be applied"
+// @Failure      412      {object} Problem                   "Song version does not match If-Match"
+// @Failure      415      {object} Problem                   "Unsupported patch format"
+// @Failure      422      {object} Problem                   "Invalid song fields"
+// @Failure      500      {object} Problem                   "Internal Server Error"
 // @Router       /songs/{id} [patch]
 func (h *SongHandler) PartialUpdateSong(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.PartialUpdateSong")
@@ -44,48 +60,55 @@ func (h *SongHandler) PartialUpdateSong(c *gin.Context) {
 
 	id, err := uuid.Parse(c.Param(pathParamID))
 	if err != nil {
-		c.String(http.StatusBadRequest, "Invalid ID format")
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
 		return
 	}
 
-	var request PartialUpdateSongRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+	body, err := c.GetRawData()
+	if err != nil {
+		h.logger.Debug("failed to read request body", slog.String("error", err.Error()))
 
-		c.String(http.StatusBadRequest, err.Error())
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Failed to read request body")
 		return
 	}
 
-	song := models.Song{
-		ID:          id,
-		Name:        request.Name,
-		Group:       request.Group,
-		ReleaseDate: request.ReleaseDate,
-		Text:        request.Text,
-		Link:        request.Link,
-	}
+	var patch jsonpatch.Patcher
 
-	updatedSong, err := h.songService.UpdateSong(ctx, song)
-	if err != nil {
-		if errors.Is(err, repo.ErrObjectNotFound) {
-			c.String(http.StatusNotFound, err.Error())
+	switch c.ContentType() {
+	case contentTypeJSONPatch:
+		if patch, err = jsonpatch.DecodePatch(body); err != nil {
+			h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+
+			writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
 			return
 		}
-
-		if errors.Is(err, repo.ErrDuplicate) {
-			c.String(http.StatusConflict, err.Error())
+	case contentTypeMergePatch, binding.MIMEJSON, "":
+		if !json.Valid(body) {
+			writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Request body is not valid JSON")
 			return
 		}
 
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
+		patch = jsonpatch.MergePatch(body)
+	default:
+		writeProblem(c, http.StatusUnsupportedMediaType, ProblemUnsupportedMediaType, "Use application/merge-patch+json or application/json-patch+json")
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
+		return
+	}
 
-		c.Status(http.StatusInternalServerError)
+	updatedSong, err := h.songService.PatchSong(ctx, id, version, patch)
+	if err != nil {
+		writeError(c, h.logger, "failed to update song", err)
 		return
 	}
 
-	response := SongResponse{
+	response := PartialUpdateSongResponse{
 		Song: updatedSong,
 	}
 
+	c.Header(headerETag, songETag(updatedSong))
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"song-service/internal/application/providers"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/application/services"
+	"song-service/internal/domain/validation"
+	"song-service/internal/pkg/jsonpatch"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const contentTypeProblem = "application/problem+json"
+
+// problemTypePrefix prefixes the problem code to build the RFC 7807 problem type URI.
+const problemTypePrefix = "urn:song-service:problem:"
+
+// Stable problem codes, clients should rely on them instead of the detail text.
+const (
+	ProblemInvalidRequest       = "invalid_request"
+	ProblemUnsupportedMediaType = "unsupported_media_type"
+	ProblemNotAcceptable        = "not_acceptable"
+	ProblemValidationFailed     = "validation_failed"
+	ProblemNotFound             = "not_found"
+	ProblemAlreadyExists        = "already_exists"
+	ProblemVersionMismatch      = "version_mismatch"
+	ProblemConflict             = "conflict"
+	ProblemPatchConflict        = "patch_conflict"
+	ProblemVerseOutOfRange      = "verse_out_of_range"
+	ProblemUpstreamNotFound     = "upstream_not_found"
+	ProblemUpstreamFailed       = "upstream_failed"
+	ProblemUpstreamUnavailable  = "upstream_unavailable"
+	ProblemInternal             = "internal_error"
+)
+
+// Problem is an RFC 7807 error response.
+type Problem struct {
+	Type     string                  `json:"type"               example:"urn:song-service:problem:not_found"`
+	Title    string                  `json:"title"              example:"Not Found"`
+	Status   int                     `json:"status"             example:"404"`
+	Detail   string                  `json:"detail,omitempty"   example:"Song not found"`
+	Instance string                  `json:"instance,omitempty" example:"/songs/0b4a1f4e-4f7c-4b1a-9a8e-0a4b6c3e2d1f"`
+	Code     string                  `json:"code"               example:"not_found"`
+	TraceID  string                  `json:"trace_id,omitempty" example:"4bf92f3577b34da6a3ce929d0e0e4736"`
+	Errors   []validation.FieldError `json:"errors,omitempty"`
+}
+
+func init() {
+	// Report binding errors with the names clients use instead of the Go field names.
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterTagNameFunc(bindingFieldName)
+	}
+}
+
+func bindingFieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form", "uri", "header"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name == "-" {
+			return ""
+		}
+
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// writeProblem aborts the request with a problem of the given status, code and detail.
+func writeProblem(c *gin.Context, status int, code string, detail string) {
+	writeProblemWithErrors(c, status, code, detail, nil)
+}
+
+func writeProblemWithErrors(c *gin.Context, status int, code string, detail string, fieldErrors []validation.FieldError) {
+	problem := Problem{
+		Type:     problemTypePrefix + code,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+		Errors:   fieldErrors,
+	}
+
+	if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.HasTraceID() {
+		problem.TraceID = spanContext.TraceID().String()
+	}
+
+	c.Header("Content-Type", contentTypeProblem)
+	c.AbortWithStatusJSON(status, problem)
+}
+
+const unknownFieldPrefix = "json: unknown field "
+
+// writeBindingError reports a request that could not be bound, listing the offending
+// fields when they are known.
+func writeBindingError(c *gin.Context, err error) {
+	var (
+		validationErrs validator.ValidationErrors
+		typeErr        *json.UnmarshalTypeError
+		syntaxErr      *json.SyntaxError
+		numErr         *strconv.NumError
+	)
+
+	switch {
+	case errors.As(err, &validationErrs):
+		fieldErrors := make([]validation.FieldError, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			fieldErrors = append(fieldErrors, validation.FieldError{
+				Field:   fieldErr.Field(),
+				Code:    fieldErr.Tag(),
+				Message: bindingRuleMessage(fieldErr),
+			})
+		}
+
+		writeProblemWithErrors(c, http.StatusBadRequest, ProblemInvalidRequest, "Request parameters are invalid", fieldErrors)
+	case errors.As(err, &typeErr):
+		fieldErrors := []validation.FieldError{{
+			Field:   typeErr.Field,
+			Code:    validation.CodeInvalidType,
+			Message: fmt.Sprintf("must be %s", typeErr.Type.Kind()),
+		}}
+
+		writeProblemWithErrors(c, http.StatusBadRequest, ProblemInvalidRequest, "Request parameters are invalid", fieldErrors)
+	case errors.As(err, &syntaxErr):
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Request body is not valid JSON")
+	case errors.As(err, &numErr):
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, fmt.Sprintf("Invalid number %q", numErr.Num))
+	case strings.HasPrefix(err.Error(), unknownFieldPrefix):
+		// The decoder reports unknown members only by their message.
+		fieldErrors := []validation.FieldError{{
+			Field:   strings.Trim(strings.TrimPrefix(err.Error(), unknownFieldPrefix), `"`),
+			Code:    validation.CodeUnknownField,
+			Message: "is not allowed",
+		}}
+
+		writeProblemWithErrors(c, http.StatusBadRequest, ProblemInvalidRequest, "Request parameters are invalid", fieldErrors)
+	default:
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+	}
+}
+
+func bindingRuleMessage(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "min", "gte":
+		return "must be at least " + fieldErr.Param()
+	case "max", "lte":
+		return "must be at most " + fieldErr.Param()
+	case "oneof":
+		return "must be one of: " + fieldErr.Param()
+	default:
+		return fmt.Sprintf("must satisfy the %s rule", fieldErr.Tag())
+	}
+}
+
+// writeError maps a service error to a problem. Unexpected errors are logged with msg
+// and reported without details, so internal messages never reach clients.
+func writeError(c *gin.Context, logger *slog.Logger, msg string, err error) {
+	var validationErr *validation.Error
+
+	switch {
+	// Song info errors may wrap the violations of upstream details, these are not the
+	// caller's fault.
+	case errors.Is(err, services.ErrSongInfo):
+		writeSongInfoError(c, logger, err)
+	case errors.As(err, &validationErr):
+		writeProblemWithErrors(c, http.StatusUnprocessableEntity, ProblemValidationFailed, "Song fields are invalid", validationErr.Fields)
+	case errors.Is(err, repo.ErrObjectNotFound):
+		writeProblem(c, http.StatusNotFound, ProblemNotFound, "Resource not found")
+	case errors.Is(err, repo.ErrDuplicate):
+		writeProblem(c, http.StatusConflict, ProblemAlreadyExists, "Resource with the same name already exists")
+	case errors.Is(err, repo.ErrVersionMismatch):
+		writeProblem(c, http.StatusPreconditionFailed, ProblemVersionMismatch, "Resource version does not match")
+	case errors.Is(err, jsonpatch.ErrInvalid):
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+	case errors.Is(err, jsonpatch.ErrConflict):
+		writeProblem(c, http.StatusConflict, ProblemPatchConflict, err.Error())
+	case errors.Is(err, services.ErrVerseOutOfRange):
+		writeProblem(c, http.StatusRequestedRangeNotSatisfiable, ProblemVerseOutOfRange, err.Error())
+	default:
+		logger.Warn(msg, slog.String("error", err.Error()))
+
+		writeProblem(c, http.StatusInternalServerError, ProblemInternal, "")
+	}
+}
+
+// errorCode returns the problem code of a failed item of a bulk operation. The codes are
+// reported instead of the error messages, which may hold internal details such as the
+// addresses of the song info providers.
+func errorCode(err error) string {
+	var validationErr *validation.Error
+
+	switch {
+	case errors.Is(err, services.ErrSongInfo):
+		return songInfoErrorCode(err)
+	case errors.As(err, &validationErr):
+		return ProblemValidationFailed
+	case errors.Is(err, repo.ErrObjectNotFound):
+		return ProblemNotFound
+	case errors.Is(err, repo.ErrDuplicate):
+		return ProblemAlreadyExists
+	case errors.Is(err, repo.ErrVersionMismatch):
+		return ProblemVersionMismatch
+	default:
+		return ProblemInternal
+	}
+}
+
+// songInfoErrorCode returns the problem code of a failed song info request.
+func songInfoErrorCode(err error) string {
+	switch {
+	case errors.Is(err, providers.ErrSongNotFound):
+		return ProblemUpstreamNotFound
+	case errors.Is(err, providers.ErrUnavailable):
+		return ProblemUpstreamUnavailable
+	default:
+		return ProblemUpstreamFailed
+	}
+}
+
+// writeSongInfoError maps a failed song info request: songs missing upstream are not
+// found, unavailable providers make the service unavailable, and other upstream failures
+// are a bad gateway.
+func writeSongInfoError(c *gin.Context, logger *slog.Logger, err error) {
+	logger.Warn("failed to get song info", slog.String("error", err.Error()))
+
+	switch {
+	case errors.Is(err, providers.ErrSongNotFound):
+		writeProblem(c, http.StatusNotFound, ProblemUpstreamNotFound, "Song not found by song info providers")
+	case errors.Is(err, providers.ErrUnavailable):
+		writeProblem(c, http.StatusServiceUnavailable, ProblemUpstreamUnavailable, "Song info providers are unavailable")
+	default:
+		writeProblem(c, http.StatusBadGateway, ProblemUpstreamFailed, "Song info providers request failed")
+	}
+}
+
+// Recovery reports a panic in a handler as an internal error problem.
+func Recovery(c *gin.Context, _ any) {
+	writeProblem(c, http.StatusInternalServerError, ProblemInternal, "")
+}
+
+// NoRoute reports an unknown route as a not found problem.
+func NoRoute(c *gin.Context) {
+	writeProblem(c, http.StatusNotFound, ProblemNotFound, "Route not found")
+}
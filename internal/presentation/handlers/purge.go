@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PurgeResponse struct {
+	Purged models.PurgeResult `json:"purged"`
+}
+
+// Purge godoc
+// @Summary      Purge deleted songs and groups
+// @Description  Окончательное удаление песен и групп, удалённых раньше срока хранения, групп без песен и просроченных записей кэша
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Success      200    {object} PurgeResponse
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /admin/purge [post]
+func (h *AdminHandler) Purge(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "AdminHandler.Purge")
+	defer span.End()
+
+	result, err := h.purgeService.Purge(ctx)
+	if err != nil {
+		writeError(c, h.logger, "failed to purge deleted rows", err)
+		return
+	}
+
+	h.logger.Info("purged deleted rows", slog.Int64("songs", result.Songs), slog.Int64("groups", result.Groups), slog.Int64("cache_entries", result.CacheEntries))
+
+	response := PurgeResponse{
+		Purged: result,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RefreshSongQueryParams struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type RefreshSongResponse struct {
+	Refresh models.SongRefresh `json:"refresh"`
+}
+
+// RefreshSong godoc
+// @Summary      Refresh song
+// @Description  Повторная загрузка данных песни из поставщиков данных о песнях. Изменённые поля сохраняются как новая ревизия, в режиме dry_run изменения только возвращаются
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string  true   "Song ID"
+// @Param        dry_run  query    bool    false  "Return the changes without saving them" default(false)
+// @Success      200      {object} RefreshSongResponse
+// @Failure      400      {object} Problem "Invalid ID format"
+// @Failure      404      {object} Problem "Song not found"
+// @Failure      409      {object} Problem "Song changed during refresh"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Failure      502      {object} Problem "Song info providers failed"
+// @Failure      503      {object} Problem "Song info providers unavailable"
+// @Router       /songs/{id}/refresh [post]
+func (h *SongHandler) RefreshSong(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.RefreshSong")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	var queryParams RefreshSongQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	refresh, err := h.songRefreshService.RefreshSong(ctx, id, queryParams.DryRun)
+	if err != nil {
+		if errors.Is(err, repo.ErrVersionMismatch) {
+			writeProblem(c, http.StatusConflict, ProblemConflict, "Song changed during refresh")
+			return
+		}
+
+		writeError(c, h.logger, "failed to refresh song", err)
+		return
+	}
+
+	response := RefreshSongResponse{
+		Refresh: refresh,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"song-service/internal/application/providers"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	songRefreshLimit = 100
+)
+
+const (
+	SongRefreshStatusUpdated   = "updated"
+	SongRefreshStatusOutdated  = "outdated"
+	SongRefreshStatusUnchanged = "unchanged"
+	SongRefreshStatusNotFound  = "not_found"
+	SongRefreshStatusFailed    = "failed"
+)
+
+type RefreshSongsQueryParams struct {
+	repo.SongFilter
+	Limit  int32 `form:"limit"   binding:"omitempty,min=1,max=1000"`
+	DryRun bool  `form:"dry_run"`
+}
+
+// RefreshSongsResult is the outcome of refreshing a song. Error is the problem code of a
+// failed refresh.
+type RefreshSongsResult struct {
+	ID      uuid.UUID            `json:"id"`
+	Group   string               `json:"group"`
+	Song    string               `json:"song"`
+	Status  string               `json:"status"`
+	Changes []models.FieldChange `json:"changes,omitempty"`
+	Error   string               `json:"error,omitempty" example:"upstream_unavailable"`
+}
+
+type RefreshSongsResponse struct {
+	Results []RefreshSongsResult `json:"results"`
+}
+
+// RefreshSongs godoc
+// @Summary      Refresh songs by filter
+// @Description  Повторная загрузка данных песен, подходящих под фильтр, из поставщиков данных о песнях. Для каждой песни возвращается статус: updated, outdated (только в режиме dry_run), unchanged, not_found или failed
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        q                   query    string  false  "Full-text search by song, group and text (websearch syntax)"
+// @Param        song                query    string  false  "Name of song"
+// @Param        song_mode           query    string  false  "Match mode for song filter"  Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
+// @Param        group               query    string  false  "Group name of song"
+// @Param        group_mode          query    string  false  "Match mode for group filter" Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
+// @Param        release_date_from   query    string  false  "Start date for release date filter" example("2020-01-01")
+// @Param        release_date_to     query    string  false  "End date for release date filter"   example("2023-01-01")
+// @Param        text                query    string  false  "Text content of the song"
+// @Param        link                query    string  false  "URL link for the song"
+// @Param        link_mode           query    string  false  "Match mode for link filter"  Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
+// @Param        lang                query    string  false  "Language of a translation the song has"  example(de)
+// @Param        limit               query    int     false  "Maximum number of songs to refresh" default(100) maximum(1000)
+// @Param        dry_run             query    bool    false  "Return the changes without saving them" default(false)
+// @Success      200                 {object} RefreshSongsResponse
+// @Failure      400                 {object} Problem "Invalid query parameters"
+// @Failure      500                 {object} Problem "Internal Server Error"
+// @Router       /songs/refresh [post]
+func (h *SongHandler) RefreshSongs(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.RefreshSongs")
+	defer span.End()
+
+	var queryParams RefreshSongsQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	if !canonicalLanguages(c, queryParams.Language) {
+		return
+	}
+
+	if queryParams.Limit == 0 {
+		queryParams.Limit = songRefreshLimit
+	}
+
+	items, err := h.songRefreshService.RefreshSongs(ctx, &queryParams.SongFilter, queryParams.Limit, queryParams.DryRun)
+	if err != nil {
+		writeError(c, h.logger, "failed to refresh songs", err)
+		return
+	}
+
+	response := RefreshSongsResponse{
+		Results: make([]RefreshSongsResult, 0, len(items)),
+	}
+
+	for _, item := range items {
+		result := RefreshSongsResult{
+			ID:      item.Song.ID,
+			Group:   item.Song.Group,
+			Song:    item.Song.Name,
+			Changes: item.Refresh.Changes,
+		}
+
+		switch {
+		case item.Err == nil && item.Refresh.Applied:
+			result.Status = SongRefreshStatusUpdated
+		case item.Err == nil && len(item.Refresh.Changes) > 0:
+			result.Status = SongRefreshStatusOutdated
+		case item.Err == nil:
+			result.Status = SongRefreshStatusUnchanged
+		case errors.Is(item.Err, providers.ErrSongNotFound):
+			result.Status = SongRefreshStatusNotFound
+			result.Error = errorCode(item.Err)
+		default:
+			h.logger.Warn("failed to refresh song", slog.String("song_id", item.Song.ID.String()), slog.String("error", item.Err.Error()))
+
+			result.Status = SongRefreshStatusFailed
+			result.Error = errorCode(item.Err)
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RenameGroupRequest struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+type RenameGroupResponse struct {
+	Group models.Group `json:"group"`
+}
+
+// RenameGroup godoc
+// @Summary      Rename group by ID
+// @Description  Переименование группы. Если группа с новым названием уже существует, песни переносятся в неё
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string              true  "Group ID"
+// @Param        request  body     RenameGroupRequest  true  "New group name"
+// @Success      200      {object} RenameGroupResponse
+// @Failure      400      {object} Problem             "Invalid input data"
+// @Failure      404      {object} Problem             "Group not found"
+// @Failure      409      {object} Problem             "Song name conflict"
+// @Failure      500      {object} Problem             "Internal Server Error"
+// @Router       /groups/{id} [patch]
+func (h *GroupHandler) RenameGroup(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.RenameGroup")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	var request RenameGroupRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	group := models.Group{
+		ID:   id,
+		Name: request.Name,
+	}
+
+	renamedGroup, err := h.groupService.RenameGroup(ctx, group)
+	if err != nil {
+		writeError(c, h.logger, "failed to rename group", err)
+		return
+	}
+
+	response := RenameGroupResponse{
+		Group: renamedGroup,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RestoreGroupResponse struct {
+	Group models.Group `json:"group"`
+}
+
+// RestoreGroup godoc
+// @Summary      Restore deleted group by ID
+// @Description  Восстановление удалённой группы вместе с её песнями
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Group ID"
+// @Success      200    {object} RestoreGroupResponse
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Group not found"
+// @Failure      409    {object} Problem "Active group with the same name exists"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /groups/{id}/restore [post]
+func (h *GroupHandler) RestoreGroup(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "GroupHandler.RestoreGroup")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	restoredGroup, err := h.groupService.RestoreGroup(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to restore group", err)
+		return
+	}
+
+	response := RestoreGroupResponse{
+		Group: restoredGroup,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
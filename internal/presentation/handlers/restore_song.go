@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RestoreSongResponse struct {
+	Song models.Song `json:"song"`
+}
+
+// RestoreSong godoc
+// @Summary      Restore deleted song by ID
+// @Description  Восстановление удалённой песни по ID
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Success      200    {object} RestoreSongResponse
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Song not found"
+// @Failure      409    {object} Problem "Active song with the same name and group exists"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/restore [post]
+func (h *SongHandler) RestoreSong(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.RestoreSong")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	restoredSong, err := h.songService.RestoreSong(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to restore song", err)
+		return
+	}
+
+	response := RestoreSongResponse{
+		Song: restoredSong,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RevertSong godoc
+// @Summary      Revert song to revision
+// @Description  Восстановление состояния песни из указанной ревизии, сохраняется как новая ревизия. Восстановленные поля проверяются так же, как при частичном обновлении
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Param        rev    path     int     true  "Revision number"
+// @Param        If-Match header string  false "ETag of the song version to revert"
+// @Success      200    {object} SongResponse
+// @Header       200    {string} ETag    "Song version"
+// @Failure      400    {object} Problem "Invalid ID or revision format"
+// @Failure      404    {object} Problem "Song or revision not found"
+// @Failure      409    {object} Problem "Name conflict"
+// @Failure      412    {object} Problem "Song version does not match If-Match"
+// @Failure      422    {object} Problem "Reverted song fields are invalid"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/revisions/{rev}/revert [post]
+func (h *SongHandler) RevertSong(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.RevertSong")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	revision, err := parseRevision(c.Param(pathParamRevision))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
+		return
+	}
+
+	revertedSong, err := h.songService.RevertSong(ctx, id, version, revision)
+	if err != nil {
+		writeError(c, h.logger, "failed to revert song", err)
+		return
+	}
+
+	response := SongResponse{
+		Song: revertedSong,
+	}
+
+	c.Header(headerETag, songETag(revertedSong))
+	c.JSON(http.StatusOK, response)
+}
@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"errors"
 	"log/slog"
 	"net/http"
 	repo "song-service/internal/application/repository"
@@ -11,27 +10,70 @@ import (
 	"github.com/google/uuid"
 )
 
+// VersePaginationParams selects a page of the verses of a song, a zero limit selects every
+// verse from the offset.
+type VersePaginationParams struct {
+	Limit  int32 `form:"limit"  binding:"min=0"`
+	Offset int32 `form:"offset" binding:"min=0"`
+}
+
 type SongQueryParams struct {
-	repo.Pagination
+	VersePaginationParams
+	LanguageParams
+	Verses          bool `form:"verses"`
+	CollapseRepeats bool `form:"collapse_repeats"`
+}
+
+// VersePagination describes the page of verses of a song. VerseLimit is zero if the page
+// has no limit.
+type VersePagination struct {
+	VerseTotal  int  `json:"verse_total"`
+	VerseOffset int  `json:"verse_offset"`
+	VerseLimit  int  `json:"verse_limit"`
+	HasMore     bool `json:"has_more"`
+}
+
+func newVersePagination(page models.VersePage) *VersePagination {
+	return &VersePagination{
+		VerseTotal:  page.Total,
+		VerseOffset: page.Offset,
+		VerseLimit:  page.Limit,
+		HasMore:     page.HasMore,
+	}
 }
 
+// SongResponse is a song. The verse pagination is only set for a page of the song text,
+// and the verses of the page only when they are requested. Language is set when the text
+// is a translation.
 type SongResponse struct {
-	Song models.Song `json:"song"`
+	Song     models.Song    `json:"song"`
+	Language string         `json:"language,omitempty"`
+	Verses   []models.Verse `json:"verses,omitempty"`
+	*VersePagination
 }
 
 // Song godoc
 // @Summary      Get song
-// @Description  Получение песни с пагинацией по куплетам
+// @Description  Получение песни с пагинацией по куплетам. В ответе возвращаются общее число куплетов, смещение и размер страницы, а также признак наличия следующих куплетов. С параметром verses в ответе также возвращаются куплеты страницы с типом (куплет, припев, бридж и т.д.) и строками, для повторов указывается номер повторяемого куплета. С параметром collapse_repeats повторяющиеся куплеты (в том числе почти совпадающие) заменяются меткой повтора, так что каждая часть песни возвращается один раз. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращается оригинальный текст. Смещение за последним куплетом возвращает 416
 // @Tags         songs
 // @Accept       json
 // @Produce      json
 // @Param        id       path     string  true   "Song ID"
 // @Param        limit    query    int     false  "Limit number of verses"
 // @Param        offset   query    int     false  "Offset for pagination"
+// @Param        verses   query    bool    false  "Return parsed verses of the page" default(false)
+// @Param        collapse_repeats query bool false "Replace repeated verses with repeat markers" default(false)
+// @Param        lang     query    string  false  "Language of the translation to return" example(de)
+// @Param        Accept-Language header string false "Preferred languages of the text, used without lang"
+// @Param        If-None-Match header string false "ETag of a cached song version"
 // @Success      200      {object} SongResponse
-// @Failure      400      {string} string  "Invalid ID format"
-// @Failure      404      {string} string  "Song not found"
-// @Failure      500      {string} string  "Internal Server Error"
+// @Header       200      {string} ETag    "Song version and language of the text"
+// @Header       200      {string} Content-Language "Language of the translation, if one is returned"
+// @Success      304      {string} string  "Song version matches If-None-Match"
+// @Failure      400      {object} Problem "Invalid ID format, pagination or language"
+// @Failure      404      {object} Problem "Song not found"
+// @Failure      416      {object} Problem "Offset is past the last verse"
+// @Failure      500      {object} Problem "Internal Server Error"
 // @Router       /songs/{id} [get]
 func (h *SongHandler) Song(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.Song")
@@ -39,7 +81,7 @@ func (h *SongHandler) Song(c *gin.Context) {
 
 	id, err := uuid.Parse(c.Param(pathParamID))
 	if err != nil {
-		c.String(http.StatusBadRequest, "Invalid ID format")
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
 		return
 	}
 
@@ -47,7 +89,12 @@ func (h *SongHandler) Song(c *gin.Context) {
 	if err := c.ShouldBindQuery(&queryParams); err != nil {
 		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
 
-		c.String(http.StatusBadRequest, err.Error())
+		writeBindingError(c, err)
+		return
+	}
+
+	language, ok := languagePreference(c, queryParams.LanguageParams)
+	if !ok {
 		return
 	}
 
@@ -56,21 +103,30 @@ func (h *SongHandler) Song(c *gin.Context) {
 		Offset: queryParams.Offset,
 	}
 
-	song, err := h.songService.Song(ctx, id, &pagination)
+	song, page, err := h.songService.SongVerses(ctx, id, pagination, queryParams.CollapseRepeats, language)
 	if err != nil {
-		if errors.Is(err, repo.ErrObjectNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-			return
-		}
-
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
-
-		c.Status(http.StatusInternalServerError)
+		writeError(c, h.logger, "failed to get song", err)
 		return
 	}
 
 	response := SongResponse{
-		Song: song,
+		Song:            song,
+		Language:        page.Language,
+		VersePagination: newVersePagination(page),
+	}
+
+	if queryParams.Verses {
+		response.Verses = page.Verses
+	}
+
+	etag := songLanguageETag(response.Song, page.Language)
+
+	c.Header(headerETag, etag)
+	setContentLanguage(c, page.Language)
+
+	if header := c.GetHeader(headerIfNoneMatch); header != "" && matchETag(header, etag, true) {
+		c.Status(http.StatusNotModified)
+		return
 	}
 
 	c.JSON(http.StatusOK, response)
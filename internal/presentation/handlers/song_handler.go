@@ -1,29 +1,58 @@
 package handlers
 
 import (
+	"encoding/json"
 	"log/slog"
+	"song-service/internal/application/providers"
 	"song-service/internal/application/services"
-	"song-service/internal/presentation/client"
+	"song-service/internal/pkg/cursor"
 
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	pathParamID = "id"
+	pathParamID            = "id"
+	pathParamRevision      = "rev"
+	pathParamOtherRevision = "other"
+	pathParamVerse         = "n"
+
+	headerTotalCount = "X-Total-Count"
+	headerLink       = "Link"
+	headerLocation   = "Location"
 )
 
 type SongHandler struct {
-	songService *services.SongService
-	logger      *slog.Logger
-	tracer      trace.Tracer
-	client      *client.MusicServiceClient
+	songService        *services.SongService
+	songJobService     *services.SongJobService
+	songRefreshService *services.SongRefreshService
+	logger             *slog.Logger
+	tracer             trace.Tracer
+	songInfoProvider   providers.SongInfoProvider
+	cursorSigner       *cursor.Signer
 }
 
-func NewSongHandler(songService *services.SongService, logger *slog.Logger, tracer trace.Tracer, client *client.MusicServiceClient) *SongHandler {
+func NewSongHandler(songService *services.SongService, songJobService *services.SongJobService, songRefreshService *services.SongRefreshService, logger *slog.Logger, tracer trace.Tracer, songInfoProvider providers.SongInfoProvider, cursorSigner *cursor.Signer) *SongHandler {
 	return &SongHandler{
-		songService: songService,
-		logger:      logger,
-		tracer:      tracer,
-		client:      client,
+		songService:        songService,
+		songJobService:     songJobService,
+		songRefreshService: songRefreshService,
+		logger:             logger,
+		tracer:             tracer,
+		songInfoProvider:   songInfoProvider,
+		cursorSigner:       cursorSigner,
+	}
+}
+
+// shouldBindStrictJSON binds the JSON body like ShouldBindJSON, but rejects unknown fields.
+func shouldBindStrictJSON(c *gin.Context, obj any) error {
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(obj); err != nil {
+		return err
 	}
+
+	return binding.Validator.ValidateStruct(obj)
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongJobResponse struct {
+	Job models.SongJob `json:"job"`
+}
+
+// SongJob godoc
+// @Summary      Get song job
+// @Description  Получение статуса задачи добавления песни: pending, running, succeeded или dead. Для выполненной задачи возвращается ID песни, для неудачной — последняя ошибка
+// @Tags         jobs
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string  true   "Job ID"
+// @Success      200      {object} SongJobResponse
+// @Failure      400      {object} Problem "Invalid ID format"
+// @Failure      404      {object} Problem "Job not found"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /jobs/{id} [get]
+func (h *JobHandler) SongJob(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "JobHandler.SongJob")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	job, err := h.songJobService.SongJob(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song job", err)
+		return
+	}
+
+	response := SongJobResponse{
+		Job: job,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
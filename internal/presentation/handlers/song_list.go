@@ -1,9 +1,15 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 	repo "song-service/internal/application/repository"
+	"strconv"
+	"strings"
 
 	"song-service/internal/domain/models"
 
@@ -13,29 +19,93 @@ import (
 type SongListQueryParams struct {
 	repo.SongFilter
 	repo.Pagination
+	Sort         string `form:"sort"`
+	Cursor       string `form:"cursor"`
+	IncludeTotal bool   `form:"include_total"`
+}
+
+// SortFields parses a comma separated list of sort keys, a leading "-" means descending order.
+func (p SongListQueryParams) SortFields() ([]repo.SortField, error) {
+	if p.Sort == "" {
+		return nil, nil
+	}
+
+	var (
+		sortFields = make([]repo.SortField, 0)
+		seen       = make(map[string]bool)
+	)
+
+	for _, key := range strings.Split(p.Sort, ",") {
+		key = strings.TrimSpace(key)
+
+		field := repo.SortField{
+			Field:      strings.TrimPrefix(key, "-"),
+			Descending: strings.HasPrefix(key, "-"),
+		}
+
+		switch field.Field {
+		case repo.SongSortName, repo.SongSortGroup, repo.SongSortReleaseDate:
+		case repo.SongSortRelevance:
+			if p.Query == "" {
+				return nil, fmt.Errorf("sort by %s requires q parameter", field.Field)
+			}
+		case repo.SongSortSimilarity:
+			if !p.IsFuzzy() {
+				return nil, fmt.Errorf("sort by %s requires fuzzy match mode", field.Field)
+			}
+		default:
+			return nil, fmt.Errorf("invalid sort field: %q", field.Field)
+		}
+
+		if seen[field.Field] {
+			return nil, fmt.Errorf("duplicate sort field: %q", field.Field)
+		}
+
+		seen[field.Field] = true
+
+		sortFields = append(sortFields, field)
+	}
+
+	return sortFields, nil
 }
 
 type SongListResponse struct {
-	SongList []models.Song `json:"song_list"`
+	SongList   []models.SongMatch `json:"song_list"`
+	NextCursor *string            `json:"next_cursor,omitempty"`
+	PrevCursor *string            `json:"prev_cursor,omitempty"`
+	Total      *int64             `json:"total,omitempty"`
+	Limit      int32              `json:"limit"`
+	Offset     int32              `json:"offset"`
+	HasMore    bool               `json:"has_more"`
 }
 
 // SongList godoc
 // @Summary      Get songs list
-// @Description  Получение списка песен с фильтрацией по всем полям и пагинацией
+// @Description  Получение списка песен с фильтрацией по всем полям, полнотекстовым поиском и пагинацией
 // @Tags         songs
 // @Accept       json
 // @Produce      json
+// @Param        q                   query    string  false  "Full-text search by song, group and text (websearch syntax)"
 // @Param        song                query    string  false  "Name of song"
+// @Param        song_mode           query    string  false  "Match mode for song filter"  Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
 // @Param        group               query    string  false  "Group name of song"
+// @Param        group_mode          query    string  false  "Match mode for group filter" Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
 // @Param        release_date_from   query    string  false  "Start date for release date filter" example("2020-01-01")
 // @Param        release_date_to     query    string  false  "End date for release date filter"   example("2023-01-01")
 // @Param        text                query    string  false  "Text content of the song"
 // @Param        link                query    string  false  "URL link for the song"
-// @Param        limit               query    int     false  "Limit of songs"        default(10)
+// @Param        link_mode           query    string  false  "Match mode for link filter"  Enums(exact, insensitive, prefix, contains, fuzzy)  default(exact)
+// @Param        lang                query    string  false  "Language of a translation the song has"  example(de)
+// @Param        sort                query    string  false  "Comma separated sort keys: song, group, release_date, relevance, similarity. Prefix with - for descending order" example(-release_date,group,song)
+// @Param        limit               query    int     false  "Limit of songs"        default(10) maximum(1000)
 // @Param        offset              query    int     false  "Offset for pagination" default(0)
+// @Param        cursor              query    string  false  "Opaque cursor from next_cursor or prev_cursor of a previous page, replaces offset"
+// @Param        include_total       query    bool    false  "Count all songs matching the filter" default(false)
 // @Success      200                 {object} SongListResponse
-// @Failure      400                 {string} string  "Invalid query parameters"
-// @Failure      500                 {string} string  "Internal Server Error"
+// @Header       200                 {integer} X-Total-Count  "Number of songs matching the filter, only with include_total"
+// @Header       200                 {string}  Link           "RFC 8288 links to the first, previous, next and last pages"
+// @Failure      400                 {object} Problem "Invalid query parameters"
+// @Failure      500                 {object} Problem "Internal Server Error"
 // @Router       /songs [get]
 func (h *SongHandler) SongList(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongList")
@@ -45,21 +115,135 @@ func (h *SongHandler) SongList(c *gin.Context) {
 	if err := c.ShouldBindQuery(&queryParams); err != nil {
 		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
 
-		c.String(http.StatusBadRequest, err.Error())
+		writeBindingError(c, err)
+		return
+	}
+
+	if !canonicalLanguages(c, queryParams.Language) {
 		return
 	}
 
-	songList, err := h.songService.SongList(ctx, &queryParams.SongFilter, &queryParams.Pagination)
+	sortFields, err := queryParams.SortFields()
 	if err != nil {
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
+		h.logger.Debug("failed to parse sort parameter", slog.String("error", err.Error()))
 
-		c.Status(http.StatusInternalServerError)
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	queryParams.Pagination.Sort = sortFields
+
+	if queryParams.Cursor != "" {
+		cursor, err := h.decodeSongCursor(queryParams)
+		if err != nil {
+			h.logger.Debug("failed to parse cursor parameter", slog.String("error", err.Error()))
+
+			writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+			return
+		}
+
+		queryParams.Pagination.Cursor = cursor
+	}
+
+	result, err := h.songService.SongList(ctx, &queryParams.SongFilter, &queryParams.Pagination, queryParams.IncludeTotal)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song list", err)
 		return
 	}
 
 	response := SongListResponse{
-		SongList: songList,
+		SongList: result.SongList,
+		Total:    result.Total,
+		Limit:    queryParams.Limit,
+		Offset:   queryParams.Offset,
+		HasMore:  result.HasMore,
+	}
+
+	if response.NextCursor, err = h.encodeSongCursor(result.NextCursor); err != nil {
+		writeError(c, h.logger, "failed to encode cursor", err)
+		return
+	}
+
+	if response.PrevCursor, err = h.encodeSongCursor(result.PrevCursor); err != nil {
+		writeError(c, h.logger, "failed to encode cursor", err)
+		return
+	}
+
+	if response.Total != nil {
+		c.Header(headerTotalCount, strconv.FormatInt(*response.Total, 10))
+	}
+
+	if links := songListLinks(c.Request.URL, response); links != "" {
+		c.Header(headerLink, links)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
+
+// songListLinks builds the RFC 8288 Link header value for the song list page.
+// The last page link is only known when the total count is requested.
+func songListLinks(requestURL *url.URL, response SongListResponse) string {
+	link := func(rel string, set map[string]string) string {
+		query := requestURL.Query()
+		query.Del("cursor")
+		query.Del("offset")
+
+		for key, value := range set {
+			query.Set(key, value)
+		}
+
+		pageURL := url.URL{Path: requestURL.Path, RawQuery: query.Encode()}
+
+		return fmt.Sprintf(`<%s>; rel="%s"`, pageURL.String(), rel)
+	}
+
+	links := []string{link("first", nil)}
+
+	if response.PrevCursor != nil {
+		links = append(links, link("prev", map[string]string{"cursor": *response.PrevCursor}))
+	}
+
+	if response.NextCursor != nil {
+		links = append(links, link("next", map[string]string{"cursor": *response.NextCursor}))
+	}
+
+	if response.Total != nil && response.Limit > 0 && *response.Total > 0 {
+		lastOffset := (*response.Total - 1) / int64(response.Limit) * int64(response.Limit)
+
+		links = append(links, link("last", map[string]string{"offset": strconv.FormatInt(lastOffset, 10)}))
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func (h *SongHandler) decodeSongCursor(queryParams SongListQueryParams) (*repo.Cursor, error) {
+	if queryParams.Offset != 0 {
+		return nil, errors.New("cursor and offset parameters are mutually exclusive")
+	}
+
+	var cursor repo.Cursor
+	if err := h.cursorSigner.Decode(queryParams.Cursor, &cursor); err != nil {
+		return nil, err
+	}
+
+	sort := repo.EffectiveSongSort(&queryParams.SongFilter, queryParams.Pagination.Sort)
+
+	if !slices.Equal(cursor.Sort, sort) || len(cursor.Values) != len(cursor.Sort) {
+		return nil, errors.New("cursor does not match sort order")
+	}
+
+	return &cursor, nil
+}
+
+func (h *SongHandler) encodeSongCursor(cursor *repo.Cursor) (*string, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	token, err := h.cursorSigner.Encode(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongRevisionResponse struct {
+	Revision models.SongRevision `json:"revision"`
+}
+
+// SongRevision godoc
+// @Summary      Get song revision
+// @Description  Получение состояния песни в указанной ревизии
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Param        rev    path     int     true  "Revision number"
+// @Success      200    {object} SongRevisionResponse
+// @Failure      400    {object} Problem "Invalid ID or revision format"
+// @Failure      404    {object} Problem "Revision not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/revisions/{rev} [get]
+func (h *SongHandler) SongRevision(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongRevision")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	revision, err := parseRevision(c.Param(pathParamRevision))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	songRevision, err := h.songService.SongRevision(ctx, id, revision)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song revision", err)
+		return
+	}
+
+	response := SongRevisionResponse{
+		Revision: songRevision,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongRevisionDiffResponse struct {
+	Diff models.SongDiff `json:"diff"`
+}
+
+// SongRevisionDiff godoc
+// @Summary      Compare song revisions
+// @Description  Сравнение двух ревизий песни по полям и по куплетам текста
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Param        rev    path     int     true  "Revision to compare from"
+// @Param        other  path     int     true  "Revision to compare to"
+// @Success      200    {object} SongRevisionDiffResponse
+// @Failure      400    {object} Problem "Invalid ID or revision format"
+// @Failure      404    {object} Problem "Revision not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/revisions/{rev}/diff/{other} [get]
+func (h *SongHandler) SongRevisionDiff(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongRevisionDiff")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	from, err := parseRevision(c.Param(pathParamRevision))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	to, err := parseRevision(c.Param(pathParamOtherRevision))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	songDiff, err := h.songService.SongRevisionDiff(ctx, id, from, to)
+	if err != nil {
+		writeError(c, h.logger, "failed to compare song revisions", err)
+		return
+	}
+
+	response := SongRevisionDiffResponse{
+		Diff: songDiff,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
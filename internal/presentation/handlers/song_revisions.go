@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"song-service/internal/domain/models"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongRevisionsResponse struct {
+	RevisionList []models.SongRevision `json:"revision_list"`
+}
+
+// SongRevisions godoc
+// @Summary      Get song revisions
+// @Description  Получение истории изменений песни
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Success      200    {object} SongRevisionsResponse
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Song not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/revisions [get]
+func (h *SongHandler) SongRevisions(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongRevisions")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	revisionList, err := h.songService.SongRevisions(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song revisions", err)
+		return
+	}
+
+	response := SongRevisionsResponse{
+		RevisionList: revisionList,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseRevision(value string) (int32, error) {
+	revision, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || revision <= 0 {
+		return 0, errors.New("Invalid revision format")
+	}
+
+	return int32(revision), nil
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongTranslationResponse struct {
+	Translation models.SongTranslation `json:"translation"`
+}
+
+// SongTranslation godoc
+// @Summary      Get song translation
+// @Description  Получение перевода текста песни на язык, заданный тегом BCP 47
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Param        lang   path     string  true  "Language tag" example(de)
+// @Success      200    {object} SongTranslationResponse
+// @Failure      400    {object} Problem "Invalid ID format or language"
+// @Failure      404    {object} Problem "Song or translation not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/translations/{lang} [get]
+func (h *SongHandler) SongTranslation(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongTranslation")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	language, ok := canonicalLanguage(c, c.Param(pathParamLanguage))
+	if !ok {
+		return
+	}
+
+	translation, err := h.songService.SongTranslation(ctx, id, language)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song translation", err)
+		return
+	}
+
+	response := SongTranslationResponse{
+		Translation: translation,
+	}
+
+	c.Header(headerContentLanguage, translation.Language)
+	c.JSON(http.StatusOK, response)
+}
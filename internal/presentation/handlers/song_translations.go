@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongTranslationsResponse struct {
+	Translations []models.SongTranslation `json:"translations"`
+}
+
+// SongTranslations godoc
+// @Summary      Get song translations
+// @Description  Получение всех переводов текста песни, упорядоченных по языку
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Success      200    {object} SongTranslationsResponse
+// @Failure      400    {object} Problem "Invalid ID format"
+// @Failure      404    {object} Problem "Song not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/translations [get]
+func (h *SongHandler) SongTranslations(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongTranslations")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	translations, err := h.songService.SongTranslations(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song translations", err)
+		return
+	}
+
+	response := SongTranslationsResponse{
+		Translations: translations,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/models"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongVerseResponse struct {
+	Verse      models.Verse `json:"verse"`
+	Language   string       `json:"language,omitempty"`
+	VerseTotal int          `json:"verse_total"`
+}
+
+// SongVerse godoc
+// @Summary      Get song verse
+// @Description  Получение куплета песни по его номеру, номера куплетов начинаются с нуля. В ответе также возвращается общее число куплетов. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращается куплет оригинального текста
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id     path     string  true  "Song ID"
+// @Param        n      path     int     true  "Verse number, starting from 0"
+// @Param        lang   query    string  false "Language of the translation to return" example(de)
+// @Param        Accept-Language header string false "Preferred languages of the text, used without lang"
+// @Success      200    {object} SongVerseResponse
+// @Header       200    {string} Content-Language "Language of the translation, if one is returned"
+// @Failure      400    {object} Problem "Invalid ID, verse number format or language"
+// @Failure      404    {object} Problem "Song or verse not found"
+// @Failure      500    {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/verses/{n} [get]
+func (h *SongHandler) SongVerse(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongVerse")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	index, err := parseVerseIndex(c.Param(pathParamVerse))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, err.Error())
+		return
+	}
+
+	var queryParams LanguageParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	language, ok := languagePreference(c, queryParams)
+	if !ok {
+		return
+	}
+
+	page, err := h.songService.SongVerse(ctx, id, index, language)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song verse", err)
+		return
+	}
+
+	response := SongVerseResponse{
+		Verse:      page.Verses[0],
+		Language:   page.Language,
+		VerseTotal: page.Total,
+	}
+
+	setContentLanguage(c, page.Language)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseVerseIndex(value string) (int, error) {
+	index, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || index < 0 {
+		return 0, errors.New("Invalid verse number format")
+	}
+
+	return int(index), nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	repo "song-service/internal/application/repository"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SongVersesQueryParams struct {
+	VersePaginationParams
+	LanguageParams
+	CollapseRepeats bool `form:"collapse_repeats"`
+}
+
+type SongVersesResponse struct {
+	Verses   []models.Verse `json:"verses"`
+	Language string         `json:"language,omitempty"`
+	VersePagination
+}
+
+// SongVerses godoc
+// @Summary      Get song verses
+// @Description  Получение куплетов песни с пагинацией. Каждый куплет возвращается с типом (куплет, припев, бридж и т.д.) и строками, в ответе также возвращаются общее число куплетов, смещение и размер страницы и признак наличия следующих куплетов. Для повторов указывается номер повторяемого куплета, с параметром collapse_repeats их строки заменяются меткой повтора. Параметр lang или заголовок Accept-Language выбирают перевод текста, при отсутствии подходящего перевода или если язык оригинала подходит не хуже возвращаются куплеты оригинального текста. Смещение за последним куплетом возвращает 416
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string  true   "Song ID"
+// @Param        limit    query    int     false  "Limit number of verses"
+// @Param        offset   query    int     false  "Offset for pagination"
+// @Param        collapse_repeats query bool false "Replace repeated verses with repeat markers" default(false)
+// @Param        lang     query    string  false  "Language of the translation to return" example(de)
+// @Param        Accept-Language header string false "Preferred languages of the text, used without lang"
+// @Success      200      {object} SongVersesResponse
+// @Header       200      {string} Content-Language "Language of the translation, if one is returned"
+// @Failure      400      {object} Problem "Invalid ID format, pagination or language"
+// @Failure      404      {object} Problem "Song not found"
+// @Failure      416      {object} Problem "Offset is past the last verse"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/verses [get]
+func (h *SongHandler) SongVerses(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SongVerses")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	var queryParams SongVersesQueryParams
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		h.logger.Debug("failed to parse query parameters", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	language, ok := languagePreference(c, queryParams.LanguageParams)
+	if !ok {
+		return
+	}
+
+	pagination := repo.Pagination{
+		Limit:  queryParams.Limit,
+		Offset: queryParams.Offset,
+	}
+
+	_, page, err := h.songService.SongVerses(ctx, id, pagination, queryParams.CollapseRepeats, language)
+	if err != nil {
+		writeError(c, h.logger, "failed to get song verses", err)
+		return
+	}
+
+	response := SongVersesResponse{
+		Verses:          page.Verses,
+		Language:        page.Language,
+		VersePagination: *newVersePagination(page),
+	}
+
+	setContentLanguage(c, page.Language)
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+	"song-service/internal/domain/lyrics"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+)
+
+const (
+	contentTypeLRC    = "text/x-lrc"
+	contentTypeWebVTT = "text/vtt"
+
+	headerVary = "Vary"
+)
+
+// syncedLyricsFormats are the representations of synced lyrics in order of preference,
+// plain text is served as LRC.
+var syncedLyricsFormats = []string{binding.MIMEJSON, contentTypeLRC, contentTypeWebVTT, binding.MIMEPlain}
+
+type SyncedLyricsResponse struct {
+	Lyrics models.SyncedLyrics `json:"lyrics"`
+}
+
+// SyncedLyrics godoc
+// @Summary      Get synced lyrics
+// @Description  Получение синхронизированного текста песни с временными метками строк. Формат ответа выбирается по заголовку Accept: JSON (по умолчанию), LRC (text/x-lrc или text/plain) или WebVTT (text/vtt)
+// @Tags         songs
+// @Produce      json,text/x-lrc,text/vtt,plain
+// @Param        id      path     string  true  "Song ID"
+// @Success      200     {object} SyncedLyricsResponse
+// @Failure      400     {object} Problem "Invalid ID format"
+// @Failure      404     {object} Problem "Song or synced lyrics not found"
+// @Failure      406     {object} Problem "Requested format is not supported"
+// @Failure      500     {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/lyrics/synced [get]
+func (h *SongHandler) SyncedLyrics(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.SyncedLyrics")
+	defer span.End()
+
+	c.Header(headerVary, "Accept")
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	format := c.NegotiateFormat(syncedLyricsFormats...)
+	if format == "" {
+		writeProblem(c, http.StatusNotAcceptable, ProblemNotAcceptable, "Use application/json, text/x-lrc or text/vtt")
+		return
+	}
+
+	synced, err := h.songService.SyncedLyrics(ctx, id)
+	if err != nil {
+		writeError(c, h.logger, "failed to get synced lyrics", err)
+		return
+	}
+
+	switch format {
+	case contentTypeLRC, binding.MIMEPlain:
+		c.Data(http.StatusOK, format+"; charset=utf-8", []byte(lyrics.FormatLRC(synced)))
+	case contentTypeWebVTT:
+		c.Data(http.StatusOK, format+"; charset=utf-8", []byte(lyrics.FormatWebVTT(synced)))
+	default:
+		response := SyncedLyricsResponse{
+			Lyrics: synced,
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
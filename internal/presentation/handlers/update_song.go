@@ -1,10 +1,8 @@
 package handlers
 
 import (
-	"errors"
 	"log/slog"
 	"net/http"
-	repo "song-service/internal/application/repository"
 	"song-service/internal/domain/models"
 
 	"github.com/gin-gonic/gin"
@@ -12,12 +10,16 @@ import (
 	"github.com/hardfinhq/go-date"
 )
 
+// UpdateSongRequest replaces every field of a song, so all of them must be present. Text and
+// link must not be empty, use PATCH to clear them. The language of the text is optional,
+// omitting it clears it.
 type UpdateSongRequest struct {
-	Name        string    `json:"song"         binding:"required"`
-	Group       string    `json:"group"        binding:"required"`
-	ReleaseDate date.Date `json:"release_date" binding:"required" swaggertype:"primitive,string"`
-	Text        string    `json:"text"         binding:"required"`
-	Link        string    `json:"link"         binding:"required"`
+	Name        *string    `json:"song"         binding:"required"`
+	Group       *string    `json:"group"        binding:"required"`
+	ReleaseDate *date.Date `json:"release_date" binding:"required" swaggertype:"primitive,string"`
+	Text        *string    `json:"text"         binding:"required"`
+	Link        *string    `json:"link"         binding:"required"`
+	Language    string     `json:"language"     example:"en"`
 }
 
 type UpdateSongResponse struct {
@@ -26,17 +28,21 @@ type UpdateSongResponse struct {
 
 // UpdateSong godoc
 // @Summary      Update song by ID
-// @Description  Полное обновление информации о песне в библиотеке
+// @Description  Полная замена информации о песне в библиотеке. Все поля, кроме языка текста, обязательны, текст и ссылка не могут быть пустыми (очистить их можно через PATCH), неизвестные поля отклоняются
 // @Tags         songs
 // @Accept       json
 // @Produce      json
 // @Param        id       path     string             true   "Song ID"
 // @Param        request  body     UpdateSongRequest  true   "Song details to update"
+// @Param        If-Match header   string             false  "ETag of the song version to update"
 // @Success      200      {object} UpdateSongResponse
-// @Failure      400      {string} string             "Invalid input data"
-// @Failure      404      {string} string             "Song not found"
-// @Failure      409      {string} string             "Name conflict"
-// @Failure      500      {string} string             "Internal Server Error"
+// @Header       200      {string} ETag               "Song version"
+// @Failure      400      {object} Problem            "Invalid input data"
+// @Failure      404      {object} Problem            "Song not found"
+// @Failure      409      {object} Problem            "Name conflict"
+// @Failure      412      {object} Problem            "Song version does not match If-Match"
+// @Failure      422      {object} Problem            "Invalid song fields"
+// @Failure      500      {object} Problem            "Internal Server Error"
 // @Router       /songs/{id} [put]
 func (h *SongHandler) UpdateSong(c *gin.Context) {
 	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.UpdateSong")
@@ -44,48 +50,44 @@ func (h *SongHandler) UpdateSong(c *gin.Context) {
 
 	id, err := uuid.Parse(c.Param(pathParamID))
 	if err != nil {
-		c.String(http.StatusBadRequest, "Invalid ID format")
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
 		return
 	}
 
 	var request UpdateSongRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
+	if err := shouldBindStrictJSON(c, &request); err != nil {
 		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
 
-		c.String(http.StatusBadRequest, err.Error())
+		writeBindingError(c, err)
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
 		return
 	}
 
 	song := models.Song{
 		ID:          id,
-		Name:        request.Name,
-		Group:       request.Group,
-		ReleaseDate: request.ReleaseDate,
-		Text:        request.Text,
-		Link:        request.Link,
+		Name:        *request.Name,
+		Group:       *request.Group,
+		ReleaseDate: *request.ReleaseDate,
+		Text:        *request.Text,
+		Link:        *request.Link,
+		Language:    request.Language,
+		Version:     version,
 	}
 
 	updatedSong, err := h.songService.UpdateSong(ctx, song)
 	if err != nil {
-		if errors.Is(err, repo.ErrObjectNotFound) {
-			c.String(http.StatusNotFound, err.Error())
-			return
-		}
-
-		if errors.Is(err, repo.ErrDuplicate) {
-			c.String(http.StatusConflict, err.Error())
-			return
-		}
-
-		h.logger.Warn("failed to create song", slog.String("error", err.Error()))
-
-		c.Status(http.StatusInternalServerError)
+		writeError(c, h.logger, "failed to update song", err)
 		return
 	}
 
-	response := SongResponse{
+	response := UpdateSongResponse{
 		Song: updatedSong,
 	}
 
+	c.Header(headerETag, songETag(updatedSong))
 	c.JSON(http.StatusOK, response)
 }
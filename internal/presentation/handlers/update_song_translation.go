@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type UpdateSongTranslationRequest struct {
+	Text *string `json:"text" binding:"required"`
+}
+
+type UpdateSongTranslationResponse struct {
+	Translation models.SongTranslation `json:"translation"`
+}
+
+// UpdateSongTranslation godoc
+// @Summary      Add or update song translation
+// @Description  Добавление или замена перевода текста песни на язык, заданный тегом BCP 47. Тег приводится к каноническому виду, например pt_br сохраняется как pt-BR. Изменение перевода меняет версию песни
+// @Tags         songs
+// @Accept       json
+// @Produce      json
+// @Param        id       path     string                        true   "Song ID"
+// @Param        lang     path     string                        true   "Language tag" example(de)
+// @Param        request  body     UpdateSongTranslationRequest  true   "Translated text"
+// @Param        If-Match header   string                        false  "ETag of the song version to update"
+// @Success      200      {object} UpdateSongTranslationResponse
+// @Header       200      {string} ETag    "Song version"
+// @Failure      400      {object} Problem "Invalid input data or language"
+// @Failure      404      {object} Problem "Song not found"
+// @Failure      412      {object} Problem "Song version does not match If-Match"
+// @Failure      422      {object} Problem "Invalid translation fields"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/translations/{lang} [put]
+func (h *SongHandler) UpdateSongTranslation(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.UpdateSongTranslation")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	language, ok := canonicalLanguage(c, c.Param(pathParamLanguage))
+	if !ok {
+		return
+	}
+
+	var request UpdateSongTranslationRequest
+	if err := shouldBindStrictJSON(c, &request); err != nil {
+		h.logger.Debug("failed to parse request body", slog.String("error", err.Error()))
+
+		writeBindingError(c, err)
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
+		return
+	}
+
+	translation := models.SongTranslation{
+		Language: language,
+		Text:     *request.Text,
+	}
+
+	updatedSong, updatedTranslation, err := h.songService.SaveSongTranslation(ctx, id, version, translation)
+	if err != nil {
+		writeError(c, h.logger, "failed to update song translation", err)
+		return
+	}
+
+	response := UpdateSongTranslationResponse{
+		Translation: updatedTranslation,
+	}
+
+	c.Header(headerETag, songETag(updatedSong))
+	c.JSON(http.StatusOK, response)
+}
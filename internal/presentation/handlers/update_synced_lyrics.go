@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+	"song-service/internal/domain/lyrics"
+	"song-service/internal/domain/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+)
+
+type UpdateSyncedLyricsResponse struct {
+	Song   models.Song         `json:"song"`
+	Lyrics models.SyncedLyrics `json:"lyrics"`
+}
+
+// UpdateSyncedLyrics godoc
+// @Summary      Upload synced lyrics
+// @Description  Загрузка синхронизированного текста песни в формате LRC или расширенного LRC с временными метками слов. Все строки проверяются, ошибки возвращаются с номерами строк. Текст песни заменяется текстом синхронизированных строк, при последующем изменении текста песни синхронизированный текст удаляется
+// @Tags         songs
+// @Accept       text/x-lrc,plain
+// @Produce      json
+// @Param        id       path     string  true   "Song ID"
+// @Param        request  body     string  true   "Lyrics in LRC or enhanced LRC"
+// @Param        If-Match header   string  false  "ETag of the song version to update"
+// @Success      200      {object} UpdateSyncedLyricsResponse
+// @Header       200      {string} ETag    "Song version"
+// @Failure      400      {object} Problem "Invalid ID format"
+// @Failure      404      {object} Problem "Song not found"
+// @Failure      412      {object} Problem "Song version does not match If-Match"
+// @Failure      415      {object} Problem "Unsupported lyrics format"
+// @Failure      422      {object} Problem "Invalid lyrics lines"
+// @Failure      500      {object} Problem "Internal Server Error"
+// @Router       /songs/{id}/lyrics/synced [put]
+func (h *SongHandler) UpdateSyncedLyrics(c *gin.Context) {
+	ctx, span := h.tracer.Start(c.Request.Context(), "SongHandler.UpdateSyncedLyrics")
+	defer span.End()
+
+	id, err := uuid.Parse(c.Param(pathParamID))
+	if err != nil {
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Invalid ID format")
+		return
+	}
+
+	switch c.ContentType() {
+	case contentTypeLRC, binding.MIMEPlain, "":
+	default:
+		writeProblem(c, http.StatusUnsupportedMediaType, ProblemUnsupportedMediaType, "Use text/x-lrc or text/plain")
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		h.logger.Debug("failed to read request body", slog.String("error", err.Error()))
+
+		writeProblem(c, http.StatusBadRequest, ProblemInvalidRequest, "Failed to read request body")
+		return
+	}
+
+	synced, err := lyrics.ParseLRC(string(body))
+	if err != nil {
+		writeError(c, h.logger, "failed to parse synced lyrics", err)
+		return
+	}
+
+	version, ok := h.checkIfMatch(ctx, c, id)
+	if !ok {
+		return
+	}
+
+	updatedSong, err := h.songService.UpdateSyncedLyrics(ctx, id, version, synced)
+	if err != nil {
+		writeError(c, h.logger, "failed to update synced lyrics", err)
+		return
+	}
+
+	response := UpdateSyncedLyricsResponse{
+		Song:   updatedSong,
+		Lyrics: synced,
+	}
+
+	c.Header(headerETag, songETag(updatedSong))
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,3 @@
+DROP INDEX idx_groups_name;
+
+ALTER TABLE groups ADD CONSTRAINT groups_name_key UNIQUE (name);
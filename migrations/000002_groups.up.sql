@@ -0,0 +1,3 @@
+ALTER TABLE groups DROP CONSTRAINT groups_name_key;
+
+CREATE UNIQUE INDEX idx_groups_name ON groups(name) WHERE deleted_at IS NULL;
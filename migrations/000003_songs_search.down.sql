@@ -0,0 +1,7 @@
+DROP INDEX idx_groups_search_vector;
+DROP INDEX idx_songs_search_vector;
+
+ALTER TABLE groups DROP COLUMN search_vector;
+ALTER TABLE songs DROP COLUMN search_vector;
+
+CREATE INDEX idx_songs_text ON songs(text);
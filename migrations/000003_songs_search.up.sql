@@ -0,0 +1,13 @@
+DROP INDEX idx_songs_text;
+
+ALTER TABLE songs ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', name), 'A') ||
+    setweight(to_tsvector('english', text), 'D')
+) STORED;
+
+ALTER TABLE groups ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', name), 'B')
+) STORED;
+
+CREATE INDEX idx_songs_search_vector ON songs USING GIN(search_vector);
+CREATE INDEX idx_groups_search_vector ON groups USING GIN(search_vector);
@@ -0,0 +1,5 @@
+DROP INDEX idx_groups_name_trgm;
+DROP INDEX idx_songs_link_trgm;
+DROP INDEX idx_songs_name_trgm;
+
+DROP EXTENSION IF EXISTS pg_trgm;
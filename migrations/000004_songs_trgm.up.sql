@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_songs_name_trgm ON songs USING GIN(name gin_trgm_ops);
+CREATE INDEX idx_songs_link_trgm ON songs USING GIN(link gin_trgm_ops);
+CREATE INDEX idx_groups_name_trgm ON groups USING GIN(name gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX idx_songs_name_group_id;
+
+ALTER TABLE songs ADD CONSTRAINT songs_name_group_id_key UNIQUE (name, group_id);
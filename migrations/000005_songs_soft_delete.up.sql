@@ -0,0 +1,3 @@
+ALTER TABLE songs DROP CONSTRAINT songs_name_group_id_key;
+
+CREATE UNIQUE INDEX idx_songs_name_group_id ON songs(name, group_id) WHERE deleted_at IS NULL;
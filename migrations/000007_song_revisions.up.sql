@@ -0,0 +1,27 @@
+CREATE TABLE song_revisions (
+    song_id UUID REFERENCES songs(id) ON DELETE CASCADE NOT NULL,
+    revision INTEGER NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    group_name VARCHAR(255) NOT NULL,
+    release_date DATE NOT NULL,
+    text TEXT NOT NULL,
+    link TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (song_id, revision)
+);
+
+INSERT INTO song_revisions (song_id, revision, operation, name, group_name, release_date, text, link)
+SELECT
+    s.id,
+    1,
+    'create',
+    s.name,
+    g.name,
+    s.release_date,
+    s.text,
+    s.link
+FROM
+    songs s
+JOIN
+    groups g ON s.group_id = g.id;
@@ -0,0 +1,17 @@
+CREATE TABLE song_jobs (
+    id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    group_name VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL,
+    error TEXT,
+    song_id UUID REFERENCES songs(id) ON DELETE SET NULL,
+    run_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_song_jobs_pending ON song_jobs(run_at) WHERE status = 'pending';
+CREATE INDEX idx_song_jobs_running ON song_jobs(locked_until) WHERE status = 'running';
@@ -0,0 +1,7 @@
+CREATE TABLE cache_entries (
+    key TEXT PRIMARY KEY,
+    value BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_cache_entries_expires_at ON cache_entries(expires_at);
@@ -0,0 +1,3 @@
+DROP INDEX idx_songs_refreshed_at;
+
+ALTER TABLE songs DROP COLUMN refreshed_at;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE songs ADD COLUMN refreshed_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_songs_refreshed_at ON songs(refreshed_at) WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE songs DROP COLUMN structure;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE songs ADD COLUMN structure JSONB NOT NULL DEFAULT '[]';
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE TABLE song_synced_lyrics (
+    song_id UUID PRIMARY KEY REFERENCES songs(id) ON DELETE CASCADE,
+    lyrics JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE song_lyrics;
\ No newline at end of file
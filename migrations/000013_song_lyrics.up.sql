@@ -0,0 +1,10 @@
+CREATE TABLE song_lyrics (
+    song_id UUID REFERENCES songs(id) ON DELETE CASCADE NOT NULL,
+    language VARCHAR(35) NOT NULL,
+    text TEXT NOT NULL,
+    structure JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (song_id, language)
+);
+
+CREATE INDEX idx_song_lyrics_language ON song_lyrics(language);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE song_revisions DROP COLUMN language;
+
+ALTER TABLE songs DROP COLUMN language;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE songs ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '';
+
+ALTER TABLE song_revisions ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,9 @@
+DROP INDEX idx_songs_full_search_vector;
+
+DROP TRIGGER groups_songs_search_vector ON groups;
+DROP FUNCTION groups_update_songs_search_vector;
+
+DROP TRIGGER songs_group_search_vector ON songs;
+DROP FUNCTION songs_set_group_search_vector;
+
+ALTER TABLE songs DROP COLUMN group_search_vector;
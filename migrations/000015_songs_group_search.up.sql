@@ -0,0 +1,32 @@
+ALTER TABLE songs ADD COLUMN group_search_vector TSVECTOR NOT NULL DEFAULT '';
+
+UPDATE songs s SET group_search_vector = g.search_vector FROM groups g WHERE s.group_id = g.id;
+
+-- The group vector is copied to its songs, so a search across song and group fields can use one index.
+CREATE FUNCTION songs_set_group_search_vector() RETURNS TRIGGER AS $$
+BEGIN
+    SELECT search_vector INTO NEW.group_search_vector FROM groups WHERE id = NEW.group_id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER songs_group_search_vector
+BEFORE INSERT OR UPDATE OF group_id ON songs
+FOR EACH ROW EXECUTE FUNCTION songs_set_group_search_vector();
+
+CREATE FUNCTION groups_update_songs_search_vector() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE songs SET group_search_vector = NEW.search_vector WHERE group_id = NEW.id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER groups_songs_search_vector
+AFTER UPDATE OF name ON groups
+FOR EACH ROW
+WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION groups_update_songs_search_vector();
+
+CREATE INDEX idx_songs_full_search_vector ON songs USING GIN((search_vector || group_search_vector));
@@ -11,6 +11,7 @@ POSTGRES_USER=user
 POSTGRES_PASSWORD=password
 POSTGRES_DB=song_db
 POSTGRES_SSLMODE=disable
+CURSOR_SECRET=$(head -c 32 /dev/urandom | base64)
 
 echo "CONFIG_PATH=$CONFIG_PATH" > $ENV_FILE_PATH
 echo "POSTGRES_ADDRESS=$POSTGRES_ADDRESS" >> $ENV_FILE_PATH
@@ -19,3 +20,4 @@ echo "POSTGRES_USER=$POSTGRES_USER" >> $ENV_FILE_PATH
 echo "POSTGRES_PASSWORD=$POSTGRES_PASSWORD" >> $ENV_FILE_PATH
 echo "POSTGRES_DB=$POSTGRES_DB" >> $ENV_FILE_PATH
 echo "POSTGRES_SSLMODE=$POSTGRES_SSLMODE" >> $ENV_FILE_PATH
+echo "CURSOR_SECRET=$CURSOR_SECRET" >> $ENV_FILE_PATH
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurge(t *testing.T) {
+	expiredSong := defaultSong
+
+	activeGroupSong := defaultSong
+	activeGroupSong.ID = uuid.New()
+	activeGroupSong.Group = "active-song-group"
+
+	recentSong := defaultSong
+	recentSong.ID = uuid.New()
+	recentSong.Group = "recent-song-group"
+
+	if err := SetUp(nil, []Song{expiredSong, activeGroupSong, recentSong}); err != nil {
+		t.Fatal(err)
+	}
+
+	require.Nil(t, songServiceDB.DeleteSong(expiredSong.ID, time.Now().AddDate(0, 0, -31)))
+	require.Nil(t, songServiceDB.DeleteSong(activeGroupSong.ID, time.Now().AddDate(0, 0, -31)))
+	require.Nil(t, songServiceDB.DeleteSong(recentSong.ID, time.Now()))
+
+	deletedGroupID, err := songServiceDB.CreateGroup(expiredSong.Group)
+	require.Nil(t, err)
+
+	_, _, err = songServiceClient.DeleteGroup(deletedGroupID)
+	require.Nil(t, err)
+
+	t.Run("purge expired", func(t *testing.T) {
+		resp, code, err := songServiceClient.Purge()
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(2), resp.Purged.Songs)
+		assert.Equal(t, int64(1), resp.Purged.Groups)
+
+		trashResp, _, err := songServiceClient.ListDeletedSong(nil)
+
+		require.Nil(t, err)
+		require.Equal(t, 1, len(trashResp.SongList))
+		assert.Equal(t, recentSong.ID, trashResp.SongList[0].ID)
+
+		groupResp, _, err := songServiceClient.ListGroup(GroupListQueryParams{
+			Name: []string{activeGroupSong.Group},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, 1, len(groupResp.GroupList))
+		assert.Equal(t, int64(0), groupResp.GroupList[0].SongCount)
+	})
+
+	t.Run("second purge", func(t *testing.T) {
+		resp, code, err := songServiceClient.Purge()
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, PurgeResult{}, resp.Purged)
+	})
+}
@@ -14,11 +14,16 @@ type Song struct {
 	ReleaseDate date.Date `json:"release_date"`
 	Text        string    `json:"text"`
 	Link        string    `json:"link"`
+	Language    string    `json:"language,omitempty"`
 }
 
 type CreateSongRequest struct {
-	Group string `json:"group"`
-	Song  string `json:"song"`
+	Group       string     `json:"group"`
+	Song        string     `json:"song"`
+	ReleaseDate *date.Date `json:"release_date,omitempty"`
+	Text        *string    `json:"text,omitempty"`
+	Link        *string    `json:"link,omitempty"`
+	Language    string     `json:"language,omitempty"`
 }
 
 type CreateSongResponse struct {
@@ -29,6 +34,15 @@ type DeleteSongResponse struct {
 	DeletedTime time.Time `json:"deleted_time"`
 }
 
+type DeletedSong struct {
+	Song
+	DeletedTime time.Time `json:"deleted_time"`
+}
+
+type ListDeletedSongResponse struct {
+	SongList []DeletedSong `json:"song_list"`
+}
+
 type UpdateSongRequest struct {
 	Name        string     `json:"song,omitempty"`
 	Group       string     `json:"group,omitempty"`
@@ -42,27 +56,336 @@ type UpdateSongResponse struct {
 }
 
 type SongQueryParams struct {
-	Group  string `form:"group"`
-	Song   string `form:"song"`
-	Limit  int32  `form:"limit"`
-	Offset int32  `form:"offset"`
+	Group           string `form:"group"`
+	Song            string `form:"song"`
+	Limit           int32  `form:"limit"`
+	Offset          int32  `form:"offset"`
+	Verses          bool   `form:"verses"`
+	CollapseRepeats bool   `form:"collapse_repeats"`
+	Lang            string `form:"lang,omitempty"`
+}
+
+type Verse struct {
+	Index    int      `json:"index"`
+	Type     string   `json:"type"`
+	Label    string   `json:"label"`
+	Lines    []string `json:"lines"`
+	RepeatOf *int     `json:"repeat_of"`
+}
+
+type SyncedWord struct {
+	Time int64  `json:"time_ms"`
+	Text string `json:"text"`
+}
+
+type SyncedLine struct {
+	Time  int64        `json:"time_ms"`
+	Text  string       `json:"text"`
+	Words []SyncedWord `json:"words"`
+}
+
+type SyncedLyrics struct {
+	Tags  map[string]string `json:"tags"`
+	Lines []SyncedLine      `json:"lines"`
+}
+
+type SyncedLyricsResponse struct {
+	Lyrics SyncedLyrics `json:"lyrics"`
+}
+
+type UpdateSyncedLyricsResponse struct {
+	Song   Song         `json:"song"`
+	Lyrics SyncedLyrics `json:"lyrics"`
+}
+
+type VerseStructure struct {
+	Type     string `json:"type"`
+	RepeatOf *int   `json:"repeat_of"`
 }
 
 type SongResponse struct {
-	Song Song `json:"song"`
+	Song        Song    `json:"song"`
+	Language    string  `json:"language"`
+	Verses      []Verse `json:"verses"`
+	VerseTotal  *int    `json:"verse_total"`
+	VerseOffset *int    `json:"verse_offset"`
+	VerseLimit  *int    `json:"verse_limit"`
+	HasMore     *bool   `json:"has_more"`
+}
+
+type SongVersesQueryParams struct {
+	Limit           int32  `form:"limit"`
+	Offset          int32  `form:"offset"`
+	CollapseRepeats bool   `form:"collapse_repeats"`
+	Lang            string `form:"lang,omitempty"`
+}
+
+type SongVersesResponse struct {
+	Verses      []Verse `json:"verses"`
+	Language    string  `json:"language"`
+	VerseTotal  int     `json:"verse_total"`
+	VerseOffset int     `json:"verse_offset"`
+	VerseLimit  int     `json:"verse_limit"`
+	HasMore     bool    `json:"has_more"`
+}
+
+type SongVerseQueryParams struct {
+	Lang string `form:"lang,omitempty"`
+}
+
+type SongVerseResponse struct {
+	Verse      Verse  `json:"verse"`
+	Language   string `json:"language"`
+	VerseTotal int    `json:"verse_total"`
+}
+
+type SongTranslation struct {
+	Language    string    `json:"language"`
+	Text        string    `json:"text"`
+	UpdatedTime time.Time `json:"updated_time"`
+}
+
+type UpdateSongTranslationRequest struct {
+	Text *string `json:"text"`
+}
+
+type SongTranslationResponse struct {
+	Translation SongTranslation `json:"translation"`
+}
+
+type SongTranslationsResponse struct {
+	Translations []SongTranslation `json:"translations"`
 }
 
 type SongListQueryParams struct {
+	Query           string     `form:"q"`
 	Name            []string   `form:"song"`
+	NameMode        string     `form:"song_mode"`
 	Group           []string   `form:"group"`
+	GroupMode       string     `form:"group_mode"`
 	ReleaseDateFrom *date.Date `form:"release_date_from"`
 	ReleaseDateTo   *date.Date `form:"release_date_to"`
 	Text            []string   `form:"text"`
 	Link            []string   `form:"link"`
+	LinkMode        string     `form:"link_mode"`
+	Lang            []string   `form:"lang"`
+	Sort            string     `form:"sort"`
+	Cursor          string     `form:"cursor"`
+	IncludeTotal    bool       `form:"include_total"`
 	Limit           int32      `form:"limit"`
 	Offset          int32      `form:"offset"`
 }
 
 type ListSongResponse struct {
-	SongList []Song `json:"song_list"`
+	SongList   []Song  `json:"song_list"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+	Total      *int64  `json:"total"`
+	Limit      int32   `json:"limit"`
+	Offset     int32   `json:"offset"`
+	HasMore    bool    `json:"has_more"`
+}
+
+type SongMatch struct {
+	Song
+	Rank       *float32 `json:"rank"`
+	Headline   *string  `json:"headline"`
+	Similarity *float32 `json:"similarity"`
+}
+
+type SearchSongResponse struct {
+	SongList []SongMatch `json:"song_list"`
+}
+
+type Group struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SongCount int64     `json:"song_count"`
+}
+
+type CreateGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type GroupResponse struct {
+	Group Group `json:"group"`
+}
+
+type GroupListQueryParams struct {
+	Name   []string `form:"name"`
+	Limit  int32    `form:"limit"`
+	Offset int32    `form:"offset"`
+}
+
+type ListGroupResponse struct {
+	GroupList []Group `json:"group_list"`
+}
+
+type RenameGroupRequest struct {
+	Name string `json:"name"`
+}
+
+type DeleteGroupResponse struct {
+	DeletedTime time.Time `json:"deleted_time"`
+}
+
+type PurgeResult struct {
+	Songs        int64 `json:"songs"`
+	Groups       int64 `json:"groups"`
+	CacheEntries int64 `json:"cache_entries"`
+}
+
+type PurgeResponse struct {
+	Purged PurgeResult `json:"purged"`
+}
+
+type SongRevision struct {
+	Revision    int32     `json:"revision"`
+	Operation   string    `json:"operation"`
+	CreatedTime time.Time `json:"created_time"`
+	Song        Song      `json:"song"`
+}
+
+type SongRevisionsResponse struct {
+	RevisionList []SongRevision `json:"revision_list"`
+}
+
+type SongRevisionResponse struct {
+	Revision SongRevision `json:"revision"`
+}
+
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type VerseChange struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
+
+type SongDiff struct {
+	From   int32         `json:"from"`
+	To     int32         `json:"to"`
+	Fields []FieldChange `json:"fields"`
+	Verses []VerseChange `json:"verses"`
+}
+
+type SongRevisionDiffResponse struct {
+	Diff SongDiff `json:"diff"`
+}
+
+type CreateSongBatchItem struct {
+	Group       string     `json:"group"`
+	Song        string     `json:"song"`
+	ReleaseDate *date.Date `json:"release_date,omitempty"`
+	Text        *string    `json:"text,omitempty"`
+	Link        *string    `json:"link,omitempty"`
+}
+
+type CreateSongBatchRequest struct {
+	Songs []CreateSongBatchItem `json:"songs"`
+}
+
+type CreateSongBatchQueryParams struct {
+	Atomic bool `form:"atomic"`
+}
+
+type CreateSongBatchResult struct {
+	Index      int          `json:"index"`
+	Group      string       `json:"group"`
+	Song       string       `json:"song"`
+	Status     string       `json:"status"`
+	ID         *uuid.UUID   `json:"id"`
+	Error      string       `json:"error"`
+	Violations []FieldError `json:"violations"`
+}
+
+type CreateSongBatchResponse struct {
+	Committed bool                    `json:"committed"`
+	Results   []CreateSongBatchResult `json:"results"`
+}
+
+type CreateSongQueryParams struct {
+	Async  bool   `form:"async"`
+	Enrich string `form:"enrich,omitempty"`
+}
+
+type SongJob struct {
+	ID          uuid.UUID  `json:"id"`
+	Group       string     `json:"group"`
+	Song        string     `json:"song"`
+	Status      string     `json:"status"`
+	Attempts    int32      `json:"attempts"`
+	MaxAttempts int32      `json:"max_attempts"`
+	Error       *string    `json:"error"`
+	SongID      *uuid.UUID `json:"song_id"`
+	RunTime     time.Time  `json:"run_time"`
+	CreatedTime time.Time  `json:"created_time"`
+	UpdatedTime time.Time  `json:"updated_time"`
+}
+
+type SongJobResponse struct {
+	Job SongJob `json:"job"`
+}
+
+type ProviderHealth struct {
+	Name           string `json:"name"`
+	CircuitBreaker string `json:"circuit_breaker"`
+}
+
+type HealthResponse struct {
+	Status    string           `json:"status"`
+	Providers []ProviderHealth `json:"providers"`
+}
+
+type RefreshSongQueryParams struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type SongRefresh struct {
+	Song    Song          `json:"song"`
+	Changes []FieldChange `json:"changes"`
+	Applied bool          `json:"applied"`
+}
+
+type RefreshSongResponse struct {
+	Refresh SongRefresh `json:"refresh"`
+}
+
+type RefreshSongsQueryParams struct {
+	Group  []string `form:"group"`
+	Limit  int32    `form:"limit"`
+	DryRun bool     `form:"dry_run"`
+}
+
+type RefreshSongsResult struct {
+	ID      uuid.UUID     `json:"id"`
+	Group   string        `json:"group"`
+	Song    string        `json:"song"`
+	Status  string        `json:"status"`
+	Changes []FieldChange `json:"changes"`
+	Error   string        `json:"error"`
+}
+
+type RefreshSongsResponse struct {
+	Results []RefreshSongsResult `json:"results"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail"`
+	Instance string       `json:"instance"`
+	Code     string       `json:"code"`
+	TraceID  string       `json:"trace_id"`
+	Errors   []FieldError `json:"errors"`
 }
@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	date "github.com/hardfinhq/go-date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGroup(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	req := CreateGroupRequest{
+		Name: "new-group",
+	}
+
+	t.Run("create group", func(t *testing.T) {
+		resp, code, err := songServiceClient.CreateGroup(req)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, req.Name, resp.Group.Name)
+		assert.Equal(t, int64(0), resp.Group.SongCount)
+	})
+
+	t.Run("second create group", func(t *testing.T) {
+		_, code, err := songServiceClient.CreateGroup(req)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, code)
+	})
+
+	t.Run("too long name", func(t *testing.T) {
+		_, code, err := songServiceClient.CreateGroup(CreateGroupRequest{Name: strings.Repeat("a", 256)})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestGetNonExistentGroup(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, code, err := songServiceClient.GetGroup(uuid.New())
+
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestListGroup(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, code, err := songServiceClient.ListGroup(GroupListQueryParams{
+		Name: []string{defaultSong.Group},
+	})
+
+	require.Nil(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	require.Equal(t, 1, len(resp.GroupList))
+	assert.Equal(t, defaultSong.Group, resp.GroupList[0].Name)
+	assert.Equal(t, int64(1), resp.GroupList[0].SongCount)
+}
+
+func TestRenameGroup(t *testing.T) {
+	song := Song{
+		ID:          uuid.New(),
+		Group:       "second-group",
+		Name:        "second-song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "second-song-text",
+		Link:        "https://example.com/second-song-link",
+	}
+
+	if err := SetUp(nil, []Song{defaultSong, song}); err != nil {
+		t.Fatal(err)
+	}
+
+	groupID, err := songServiceDB.CreateGroup(defaultSong.Group)
+	require.Nil(t, err)
+
+	_, header, _, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, "")
+	require.Nil(t, err)
+
+	etag := header.Get("ETag")
+
+	t.Run("rename to new name", func(t *testing.T) {
+		resp, code, err := songServiceClient.RenameGroup(groupID, RenameGroupRequest{Name: "renamed-group"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, groupID, resp.Group.ID)
+		assert.Equal(t, "renamed-group", resp.Group.Name)
+
+		songResp, _, err := songServiceClient.GetSong(defaultSong.ID, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, "renamed-group", songResp.Song.Group)
+
+		_, header, code, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, etag)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		etag = header.Get("ETag")
+	})
+
+	t.Run("rename to existing name moves songs", func(t *testing.T) {
+		resp, code, err := songServiceClient.RenameGroup(groupID, RenameGroupRequest{Name: song.Group})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, song.Group, resp.Group.Name)
+		assert.Equal(t, int64(2), resp.Group.SongCount)
+
+		_, code, err = songServiceClient.GetGroup(groupID)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		_, _, code, err = songServiceClient.GetSongIfNoneMatch(defaultSong.ID, etag)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		revisions, code, err := songServiceClient.ListSongRevision(defaultSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.NotEmpty(t, revisions.RevisionList)
+
+		revision := revisions.RevisionList[len(revisions.RevisionList)-1]
+
+		assert.Equal(t, "move", revision.Operation)
+		assert.Equal(t, song.Group, revision.Song.Group)
+	})
+}
+
+func TestRenameGroupsIntoEachOther(t *testing.T) {
+	firstSong := Song{
+		ID:          uuid.New(),
+		Group:       "first-group",
+		Name:        "first-song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "first-song-text",
+		Link:        "https://example.com/first-song-link",
+	}
+
+	secondSong := Song{
+		ID:          uuid.New(),
+		Group:       "second-group",
+		Name:        "second-song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "second-song-text",
+		Link:        "https://example.com/second-song-link",
+	}
+
+	for range 10 {
+		if err := SetUp(nil, []Song{firstSong, secondSong}); err != nil {
+			t.Fatal(err)
+		}
+
+		firstID, err := songServiceDB.CreateGroup(firstSong.Group)
+		require.Nil(t, err)
+
+		secondID, err := songServiceDB.CreateGroup(secondSong.Group)
+		require.Nil(t, err)
+
+		var (
+			wg    sync.WaitGroup
+			codes [2]int
+			errs  [2]error
+		)
+
+		renames := [2]struct {
+			id   uuid.UUID
+			name string
+		}{
+			{id: firstID, name: secondSong.Group},
+			{id: secondID, name: firstSong.Group},
+		}
+
+		for i, rename := range renames {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, codes[i], errs[i] = songServiceClient.RenameGroup(rename.id, RenameGroupRequest{Name: rename.name})
+			}()
+		}
+
+		wg.Wait()
+
+		for i := range renames {
+			require.Nil(t, errs[i])
+			assert.Equal(t, http.StatusOK, codes[i])
+		}
+
+		first, _, err := songServiceClient.GetSong(firstSong.ID, nil)
+		require.Nil(t, err)
+
+		second, _, err := songServiceClient.GetSong(secondSong.ID, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, first.Song.Group, second.Song.Group)
+	}
+}
+
+func TestDeleteAndRestoreGroup(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	groupID, err := songServiceDB.CreateGroup(defaultSong.Group)
+	require.Nil(t, err)
+
+	t.Run("delete hides songs", func(t *testing.T) {
+		_, code, err := songServiceClient.DeleteGroup(groupID)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		_, code, err = songServiceClient.GetSong(defaultSong.ID, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("restore shows songs", func(t *testing.T) {
+		resp, code, err := songServiceClient.RestoreGroup(groupID)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int64(1), resp.Group.SongCount)
+
+		_, code, err = songServiceClient.GetSong(defaultSong.ID, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
@@ -132,7 +132,7 @@ var defaultSong = Song{
 	Name:        "existent-song-name",
 	ReleaseDate: date.NewDate(2025, 1, 1),
 	Text:        "existent-song-text",
-	Link:        "existent-song-link",
+	Link:        "https://example.com/existent-song-link",
 }
 
 var nonExistentSong = Song{
@@ -141,5 +141,5 @@ var nonExistentSong = Song{
 	Name:        "non-existent-song-name",
 	ReleaseDate: date.NewDate(2025, 1, 1),
 	Text:        "non-existent-song-text",
-	Link:        "non-existent-song-link",
+	Link:        "https://example.com/non-existent-song-link",
 }
@@ -3,6 +3,7 @@ package tests
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -19,8 +20,17 @@ type songInfo struct {
 	Link        string    `json:"link"`
 }
 
+type mockFailure struct {
+	code       int
+	retryAfter string
+}
+
 type MockMusicService struct {
-	storage []Song
+	mu       sync.Mutex
+	storage  []Song
+	failures []mockFailure
+	requests int
+	delay    time.Duration
 }
 
 func NewMockMusicService() *MockMusicService {
@@ -28,11 +38,76 @@ func NewMockMusicService() *MockMusicService {
 }
 
 func (s *MockMusicService) AddSong(song Song) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.storage = append(s.storage, song)
 }
 
 func (s *MockMusicService) ClearStorage() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.storage = nil
+	s.failures = nil
+	s.requests = 0
+	s.delay = 0
+}
+
+// SetDelay makes info requests wait for the delay before responding.
+func (s *MockMusicService) SetDelay(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delay = delay
+}
+
+// FailNext makes the next count info requests fail with the code and Retry-After header.
+func (s *MockMusicService) FailNext(count int, code int, retryAfter string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range count {
+		s.failures = append(s.failures, mockFailure{code: code, retryAfter: retryAfter})
+	}
+}
+
+// Requests returns the number of info requests received since the storage was cleared.
+func (s *MockMusicService) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// receive counts an info request and returns its response delay and injected failure, if any.
+func (s *MockMusicService) receive() (mockFailure, time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+
+	if len(s.failures) == 0 {
+		return mockFailure{}, s.delay, false
+	}
+
+	failure := s.failures[0]
+	s.failures = s.failures[1:]
+
+	return failure, s.delay, true
+}
+
+func (s *MockMusicService) findSong(group string, song string) (Song, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, s := range s.storage {
+		if s.Group == group && s.Name == song {
+			return s, true
+		}
+	}
+
+	return Song{}, false
 }
 
 func (s *MockMusicService) Run() {
@@ -47,16 +122,27 @@ func (s *MockMusicService) Run() {
 			return
 		}
 
-		for _, s := range s.storage {
-			if s.Group == group && s.Name == song {
-				c.JSON(http.StatusOK, songInfo{
-					ReleaseDate: s.ReleaseDate,
-					Text:        s.Text,
-					Link:        s.Link,
-				})
+		failure, delay, ok := s.receive()
+
+		time.Sleep(delay)
 
-				return
+		if ok {
+			if failure.retryAfter != "" {
+				c.Header("Retry-After", failure.retryAfter)
 			}
+
+			c.String(failure.code, "Injected failure")
+			return
+		}
+
+		if s, ok := s.findSong(group, song); ok {
+			c.JSON(http.StatusOK, songInfo{
+				ReleaseDate: s.ReleaseDate,
+				Text:        s.Text,
+				Link:        s.Link,
+			})
+
+			return
 		}
 
 		c.String(http.StatusNotFound, "Song not found")
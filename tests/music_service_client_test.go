@@ -0,0 +1,387 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"song-service/internal/application/providers"
+	"song-service/internal/infrastructure/database/postgres"
+	pgrepo "song-service/internal/infrastructure/repository"
+	"song-service/internal/pkg/breaker"
+	"song-service/internal/pkg/cache"
+	"song-service/internal/presentation/client"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	musicServiceTimeout    = time.Second
+	musicServiceBackoff    = time.Millisecond * 10
+	musicServiceMaxBackoff = time.Second * 2
+
+	musicServiceCacheTTL      = time.Minute
+	musicServiceNotFoundTTL   = time.Millisecond * 200
+	musicServiceRequestsDelay = time.Millisecond * 200
+)
+
+func newMusicServiceClient(attempts int, failureThreshold int, openTimeout time.Duration, cache client.Cache) *client.MusicServiceClient {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	retryPolicy := client.RetryPolicy{
+		Attempts:   attempts,
+		Backoff:    musicServiceBackoff,
+		MaxBackoff: musicServiceMaxBackoff,
+	}
+
+	circuitBreaker := breaker.NewCircuitBreaker("music_service", failureThreshold, openTimeout, logger)
+
+	cachePolicy := client.CachePolicy{
+		TTL:         musicServiceCacheTTL,
+		NotFoundTTL: musicServiceNotFoundTTL,
+	}
+
+	return client.NewMusicServiceClient("music_service", &http.Client{
+		Timeout: musicServiceTimeout,
+	}, fmt.Sprintf("http://%s", mockMusicServerAddress), retryPolicy, circuitBreaker, cache, cachePolicy, logger)
+}
+
+// statusCode returns the Music Service response status of a client error.
+func statusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	var statusErr *client.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code
+	}
+
+	return 0
+}
+
+func TestMusicServiceClientRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		song             Song
+		failures         int
+		failureCode      int
+		expectedCode     int
+		expectedRequests int
+	}{
+		{
+			name:             "retry server errors",
+			song:             defaultSong,
+			failures:         2,
+			failureCode:      http.StatusInternalServerError,
+			expectedCode:     http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "retry too many requests",
+			song:             defaultSong,
+			failures:         1,
+			failureCode:      http.StatusTooManyRequests,
+			expectedCode:     http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "give up after attempts",
+			song:             defaultSong,
+			failures:         3,
+			failureCode:      http.StatusServiceUnavailable,
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedRequests: 3,
+		},
+		{
+			name:             "no retry on client errors",
+			song:             nonExistentSong,
+			expectedCode:     http.StatusNotFound,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpCreateTest(); err != nil {
+				t.Fatal(err)
+			}
+
+			musicService.FailNext(tt.failures, tt.failureCode, "")
+
+			musicServiceClient := newMusicServiceClient(3, 10, time.Minute, nil)
+
+			songInfo, err := musicServiceClient.Info(context.Background(), tt.song.Group, tt.song.Name)
+
+			assert.Equal(t, tt.expectedCode, statusCode(err))
+			assert.Equal(t, tt.expectedRequests, musicService.Requests())
+
+			if tt.expectedCode != http.StatusOK {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.song.Text, songInfo.Text)
+		})
+	}
+}
+
+func TestMusicServiceClientRetryAfter(t *testing.T) {
+	t.Run("wait retry after", func(t *testing.T) {
+		if err := SetUpCreateTest(); err != nil {
+			t.Fatal(err)
+		}
+
+		musicService.FailNext(1, http.StatusServiceUnavailable, "1")
+
+		musicServiceClient := newMusicServiceClient(3, 10, time.Minute, nil)
+
+		start := time.Now()
+
+		_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+		require.Nil(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Equal(t, 2, musicService.Requests())
+	})
+
+	t.Run("give up on long retry after", func(t *testing.T) {
+		if err := SetUpCreateTest(); err != nil {
+			t.Fatal(err)
+		}
+
+		musicService.FailNext(1, http.StatusServiceUnavailable, "60")
+
+		musicServiceClient := newMusicServiceClient(3, 10, time.Minute, nil)
+
+		_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, statusCode(err))
+		assert.Equal(t, 1, musicService.Requests())
+	})
+}
+
+func TestMusicServiceClientCircuitBreaker(t *testing.T) {
+	if err := SetUpCreateTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	const openTimeout = time.Millisecond * 200
+
+	musicServiceClient := newMusicServiceClient(1, 2, openTimeout, nil)
+
+	musicService.FailNext(3, http.StatusInternalServerError, "")
+
+	for range 2 {
+		_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, statusCode(err))
+	}
+
+	assert.Equal(t, breaker.StateOpen, musicServiceClient.BreakerState())
+
+	_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+	assert.ErrorIs(t, err, breaker.ErrOpen)
+	assert.ErrorIs(t, err, providers.ErrUnavailable)
+	assert.Equal(t, 2, musicService.Requests())
+
+	time.Sleep(openTimeout)
+
+	assert.Equal(t, breaker.StateHalfOpen, musicServiceClient.BreakerState())
+
+	// The failed probe opens the breaker again.
+	_, err = musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, statusCode(err))
+	assert.Equal(t, breaker.StateOpen, musicServiceClient.BreakerState())
+
+	time.Sleep(openTimeout)
+
+	_, err = musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+	require.Nil(t, err)
+	assert.Equal(t, breaker.StateClosed, musicServiceClient.BreakerState())
+	assert.Equal(t, 4, musicService.Requests())
+}
+
+func TestMusicServiceClientInvalidSongInfo(t *testing.T) {
+	invalidSong := defaultSong
+	invalidSong.Link = "invalid-link"
+
+	if err := SetUp([]Song{invalidSong}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	musicServiceClient := newMusicServiceClient(3, 10, time.Minute, nil)
+
+	_, err := musicServiceClient.Info(context.Background(), invalidSong.Group, invalidSong.Name)
+
+	assert.ErrorIs(t, err, providers.ErrInvalid)
+	assert.True(t, providers.Permanent(err))
+	assert.Equal(t, 1, musicService.Requests())
+}
+
+func TestMusicServiceClientMalformedResponse(t *testing.T) {
+	if err := SetUp([]Song{defaultSong}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	musicService.FailNext(1, http.StatusOK, "")
+
+	musicServiceClient := newMusicServiceClient(3, 1, time.Minute, nil)
+
+	_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+	assert.ErrorIs(t, err, providers.ErrInvalid)
+	assert.True(t, providers.Permanent(err))
+	assert.Equal(t, 1, musicService.Requests())
+	assert.Equal(t, breaker.StateClosed, musicServiceClient.BreakerState())
+}
+
+func TestHealth(t *testing.T) {
+	resp, code, err := songServiceClient.Health()
+
+	require.Nil(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", resp.Status)
+	require.Len(t, resp.Providers, 1)
+	assert.Equal(t, "music_service", resp.Providers[0].Name)
+	assert.Equal(t, "closed", resp.Providers[0].CircuitBreaker)
+}
+
+func TestMusicServiceClientCache(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	txManager := postgres.NewTransactionManager(songServiceDB.db.Pool)
+
+	backends := []struct {
+		name  string
+		cache func() client.Cache
+	}{
+		{
+			name: "memory",
+			cache: func() client.Cache {
+				return cache.NewLRU(100)
+			},
+		},
+		{
+			name: "postgres",
+			cache: func() client.Cache {
+				return pgrepo.NewCacheRepository(txManager, logger, noop.NewTracerProvider().Tracer(""))
+			},
+		},
+	}
+
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			t.Run("cache found song", func(t *testing.T) {
+				if err := SetUpCreateTest(); err != nil {
+					t.Fatal(err)
+				}
+
+				musicServiceClient := newMusicServiceClient(1, 10, time.Minute, backend.cache())
+
+				for range 2 {
+					songInfo, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+					require.Nil(t, err)
+					assert.Equal(t, defaultSong.Text, songInfo.Text)
+				}
+
+				assert.Equal(t, 1, musicService.Requests())
+				assert.Equal(t, client.CacheStats{Hits: 1, Misses: 1}, musicServiceClient.CacheStats())
+			})
+
+			t.Run("cache not found song", func(t *testing.T) {
+				if err := SetUpCreateTest(); err != nil {
+					t.Fatal(err)
+				}
+
+				musicServiceClient := newMusicServiceClient(1, 10, time.Minute, backend.cache())
+
+				for range 2 {
+					_, err := musicServiceClient.Info(context.Background(), nonExistentSong.Group, nonExistentSong.Name)
+
+					assert.ErrorIs(t, err, providers.ErrSongNotFound)
+					assert.Equal(t, http.StatusNotFound, statusCode(err))
+				}
+
+				assert.Equal(t, 1, musicService.Requests())
+
+				time.Sleep(musicServiceNotFoundTTL)
+
+				_, err := musicServiceClient.Info(context.Background(), nonExistentSong.Group, nonExistentSong.Name)
+
+				assert.Equal(t, http.StatusNotFound, statusCode(err))
+				assert.Equal(t, 2, musicService.Requests())
+			})
+
+			t.Run("do not cache server errors", func(t *testing.T) {
+				if err := SetUpCreateTest(); err != nil {
+					t.Fatal(err)
+				}
+
+				musicServiceClient := newMusicServiceClient(1, 10, time.Minute, backend.cache())
+
+				musicService.FailNext(1, http.StatusInternalServerError, "")
+
+				_, err := musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+				assert.NotNil(t, err)
+				assert.Equal(t, http.StatusInternalServerError, statusCode(err))
+
+				_, err = musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+				assert.Nil(t, err)
+				assert.Equal(t, 2, musicService.Requests())
+			})
+		})
+	}
+}
+
+func TestMusicServiceClientSingleFlight(t *testing.T) {
+	if err := SetUpCreateTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 10
+
+	musicService.SetDelay(musicServiceRequestsDelay)
+
+	musicServiceClient := newMusicServiceClient(1, 10, time.Minute, cache.NewLRU(100))
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, callers)
+
+	for i := range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, errs[i] = musicServiceClient.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, 1, musicService.Requests())
+}
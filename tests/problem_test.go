@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblem(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		endpoint       string
+		method         string
+		expectedStatus int
+		expectedCode   string
+		expectedFields []string
+	}{
+		{
+			name:           "not found",
+			endpoint:       fmt.Sprintf("/songs/%s", nonExistentSong.ID.String()),
+			method:         http.MethodGet,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "not_found",
+		},
+		{
+			name:           "invalid id",
+			endpoint:       "/songs/invalid-id",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_request",
+		},
+		{
+			name:           "invalid query parameters",
+			endpoint:       "/songs/refresh?limit=5000",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_request",
+			expectedFields: []string{"limit"},
+		},
+		{
+			name:           "unknown route",
+			endpoint:       "/unknown",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "not_found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, header, code, err := makeRequestWithHeaders[struct{}, struct{}](songServiceClient.client, songServiceClient.baseURL, tt.endpoint, tt.method, nil, nil, nil)
+
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedStatus, code)
+			assert.Equal(t, "application/problem+json", header.Get("Content-Type"))
+
+			var problem Problem
+
+			require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+
+			assert.Equal(t, tt.expectedStatus, problem.Status)
+			assert.Equal(t, tt.expectedCode, problem.Code)
+			assert.Equal(t, "urn:song-service:problem:"+tt.expectedCode, problem.Type)
+			assert.Equal(t, http.StatusText(tt.expectedStatus), problem.Title)
+			assert.NotContains(t, problem.Detail, nonExistentSong.ID.String())
+
+			fields := make([]string, 0, len(problem.Errors))
+			for _, fieldErr := range problem.Errors {
+				fields = append(fields, fieldErr.Field)
+			}
+
+			assert.ElementsMatch(t, tt.expectedFields, fields)
+		})
+	}
+}
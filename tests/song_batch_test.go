@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hardfinhq/go-date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBatch(t *testing.T) {
+	existingSong := Song{
+		ID:          uuid.New(),
+		Group:       "existing-group",
+		Name:        "existing-song",
+		ReleaseDate: date.NewDate(2020, 1, 1),
+		Text:        "existing-text",
+		Link:        "https://example.com/existing-link",
+	}
+
+	if err := SetUp([]Song{defaultSong}, []Song{existingSong}); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		manualText        = "manual-text"
+		manualLink        = "https://example.com/manual-link"
+		manualReleaseDate = date.NewDate(2021, 2, 3)
+		invalidLink       = "invalid-link"
+	)
+
+	invalidItem := CreateSongBatchItem{Group: "invalid-group", Song: "invalid-song", ReleaseDate: &manualReleaseDate, Text: &manualText, Link: &invalidLink}
+
+	request := CreateSongBatchRequest{
+		Songs: []CreateSongBatchItem{
+			{Group: defaultSong.Group, Song: defaultSong.Name},
+			{Group: nonExistentSong.Group, Song: nonExistentSong.Name},
+			{Group: "manual-group", Song: "manual-song", ReleaseDate: &manualReleaseDate, Text: &manualText, Link: &manualLink},
+			{Group: existingSong.Group, Song: existingSong.Name, ReleaseDate: &existingSong.ReleaseDate, Text: &existingSong.Text, Link: &existingSong.Link},
+			invalidItem,
+		},
+	}
+
+	t.Run("atomic", func(t *testing.T) {
+		resp, code, err := songServiceClient.CreateSongBatch(request, CreateSongBatchQueryParams{Atomic: true})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, resp.Committed)
+
+		statuses := make([]string, 0, len(resp.Results))
+		for _, result := range resp.Results {
+			statuses = append(statuses, result.Status)
+		}
+
+		assert.Equal(t, []string{"skipped", "not_found", "skipped", "skipped", "skipped"}, statuses)
+
+		listResp, _, err := songServiceClient.ListSong(nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, 1, len(listResp.SongList))
+	})
+
+	t.Run("atomic invalid", func(t *testing.T) {
+		invalidRequest := CreateSongBatchRequest{
+			Songs: []CreateSongBatchItem{request.Songs[2], invalidItem},
+		}
+
+		resp, code, err := songServiceClient.CreateSongBatch(invalidRequest, CreateSongBatchQueryParams{Atomic: true})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, resp.Committed)
+
+		require.Equal(t, 2, len(resp.Results))
+		assert.Equal(t, "skipped", resp.Results[0].Status)
+		assert.Equal(t, "invalid", resp.Results[1].Status)
+		assert.Equal(t, []FieldError{{Field: "link", Code: "invalid_url", Message: "must be an absolute http or https URL"}}, resp.Results[1].Violations)
+
+		listResp, _, err := songServiceClient.ListSong(nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, 1, len(listResp.SongList))
+	})
+
+	t.Run("non atomic", func(t *testing.T) {
+		resp, code, err := songServiceClient.CreateSongBatch(request, nil)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, resp.Committed)
+
+		statuses := make([]string, 0, len(resp.Results))
+		for _, result := range resp.Results {
+			statuses = append(statuses, result.Status)
+		}
+
+		assert.Equal(t, []string{"created", "not_found", "created", "duplicate", "invalid"}, statuses)
+
+		assert.Equal(t, "upstream_not_found", resp.Results[1].Error)
+		assert.Equal(t, "already_exists", resp.Results[3].Error)
+		assert.Equal(t, "validation_failed", resp.Results[4].Error)
+
+		require.NotNil(t, resp.Results[2].ID)
+
+		song, err := songServiceDB.GetSongByID(*resp.Results[2].ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, manualText, song.Text)
+		assert.Equal(t, manualLink, song.Link)
+		assert.Equal(t, manualReleaseDate, song.ReleaseDate)
+	})
+}
+
+func TestCreateBatchPartialDetails(t *testing.T) {
+	if err := SetUpCreateTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	manualText := "manual-text"
+
+	request := CreateSongBatchRequest{
+		Songs: []CreateSongBatchItem{
+			{Group: defaultSong.Group, Song: defaultSong.Name, Text: &manualText},
+		},
+	}
+
+	resp, code, err := songServiceClient.CreateSongBatch(request, nil)
+
+	require.Nil(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	require.Equal(t, 1, len(resp.Results))
+	assert.Equal(t, "created", resp.Results[0].Status)
+	require.NotNil(t, resp.Results[0].ID)
+
+	song, err := songServiceDB.GetSongByID(*resp.Results[0].ID)
+
+	require.Nil(t, err)
+	assert.Equal(t, manualText, song.Text)
+	assert.Equal(t, defaultSong.Link, song.Link)
+	assert.Equal(t, defaultSong.ReleaseDate, song.ReleaseDate)
+}
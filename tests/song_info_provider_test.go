@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"song-service/internal/application/providers"
+	"song-service/internal/domain/models"
+	"testing"
+
+	"github.com/hardfinhq/go-date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticProvider(songInfo models.SongInfo, err error) providers.SongInfoProvider {
+	return providers.SongInfoProviderFunc(func(context.Context, string, string) (models.SongInfo, error) {
+		return songInfo, err
+	})
+}
+
+func TestChainProvider(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var (
+		errTransient = errors.New("connection refused")
+		errNotFound  = errors.Join(providers.ErrSongNotFound)
+		errRejected  = errors.Join(providers.ErrRejected)
+		errDown      = errors.Join(providers.ErrUnavailable)
+	)
+
+	t.Run("merge results", func(t *testing.T) {
+		chain := providers.NewChainProvider([]providers.PrioritizedProvider{
+			{
+				Name:     "secondary",
+				Priority: 2,
+				Provider: staticProvider(models.SongInfo{ReleaseDate: date.NewDate(2021, 1, 1), Text: "secondary-text", Link: "secondary-link"}, nil),
+			},
+			{
+				Name:     "primary",
+				Priority: 1,
+				Provider: staticProvider(models.SongInfo{ReleaseDate: date.NewDate(2022, 1, 1), Text: "primary-text"}, nil),
+			},
+			{
+				Name:     "failing",
+				Priority: 0,
+				Provider: staticProvider(models.SongInfo{}, errTransient),
+			},
+		}, logger)
+
+		songInfo, err := chain.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.SongInfo{ReleaseDate: date.NewDate(2021, 1, 1), Text: "primary-text", Link: "secondary-link"}, songInfo)
+	})
+
+	t.Run("earliest release date of complete results", func(t *testing.T) {
+		var requests int
+
+		chain := providers.NewChainProvider([]providers.PrioritizedProvider{
+			{
+				Name:     "failing",
+				Priority: 0,
+				Provider: staticProvider(models.SongInfo{}, errNotFound),
+			},
+			{
+				Name:     "primary",
+				Priority: 1,
+				Provider: staticProvider(models.SongInfo{ReleaseDate: date.NewDate(2022, 1, 1), Text: "primary-text", Link: "primary-link"}, nil),
+			},
+			{
+				Name:     "secondary",
+				Priority: 2,
+				Provider: providers.SongInfoProviderFunc(func(context.Context, string, string) (models.SongInfo, error) {
+					requests++
+
+					return models.SongInfo{ReleaseDate: date.NewDate(2021, 1, 1), Text: "secondary-text", Link: "secondary-link"}, nil
+				}),
+			},
+		}, logger)
+
+		songInfo, err := chain.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+		require.Nil(t, err)
+		assert.Equal(t, models.SongInfo{ReleaseDate: date.NewDate(2021, 1, 1), Text: "primary-text", Link: "primary-link"}, songInfo)
+		assert.Equal(t, 1, requests)
+	})
+
+	tests := []struct {
+		name              string
+		errs              []error
+		expectedNotFound  bool
+		expectedPermanent bool
+		expectedErr       error
+	}{
+		{
+			name:              "all not found",
+			errs:              []error{errNotFound, errNotFound},
+			expectedNotFound:  true,
+			expectedPermanent: true,
+		},
+		{
+			name:        "not found and unavailable",
+			errs:        []error{errNotFound, errDown},
+			expectedErr: providers.ErrUnavailable,
+		},
+		{
+			name:              "not found and rejected",
+			errs:              []error{errNotFound, errRejected},
+			expectedPermanent: true,
+			expectedErr:       providers.ErrRejected,
+		},
+		{
+			name:        "rejected and transient",
+			errs:        []error{errRejected, errTransient},
+			expectedErr: errTransient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chainedProviders := make([]providers.PrioritizedProvider, 0, len(tt.errs))
+			for i, err := range tt.errs {
+				chainedProviders = append(chainedProviders, providers.PrioritizedProvider{
+					Name:     tt.name,
+					Priority: i,
+					Provider: staticProvider(models.SongInfo{}, err),
+				})
+			}
+
+			chain := providers.NewChainProvider(chainedProviders, logger)
+
+			_, err := chain.Info(context.Background(), defaultSong.Group, defaultSong.Name)
+
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedNotFound, errors.Is(err, providers.ErrSongNotFound))
+			assert.Equal(t, tt.expectedPermanent, providers.Permanent(err))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
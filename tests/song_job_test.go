@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	songJobWaitTimeout  = time.Second * 10
+	songJobPollInterval = time.Millisecond * 100
+)
+
+func TestGetNonExistentSongJob(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, code, err := songServiceClient.GetSongJob(uuid.New())
+
+	assert.NotNil(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestCreateAsync(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        CreateSongRequest
+		expectedStatus string
+	}{
+		{
+			name: "existent song",
+			request: CreateSongRequest{
+				Group: defaultSong.Group,
+				Song:  defaultSong.Name,
+			},
+			expectedStatus: "succeeded",
+		},
+		{
+			name: "non existent song",
+			request: CreateSongRequest{
+				Group: nonExistentSong.Group,
+				Song:  nonExistentSong.Name,
+			},
+			expectedStatus: "dead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpCreateTest(); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, code, err := songServiceClient.CreateSongAsync(tt.request)
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, http.StatusAccepted, code)
+			assert.Equal(t, "pending", resp.Job.Status)
+
+			job := waitSongJob(t, resp.Job.ID)
+
+			assert.Equal(t, tt.expectedStatus, job.Status)
+
+			if tt.expectedStatus != "succeeded" {
+				assert.NotNil(t, job.Error)
+				assert.Nil(t, job.SongID)
+				return
+			}
+
+			require.NotNil(t, job.SongID)
+
+			song, err := songServiceDB.GetSongByID(*job.SongID)
+
+			require.Nil(t, err)
+			assert.Equal(t, defaultSong.Text, song.Text)
+		})
+	}
+}
+
+func TestCreateAsyncValidation(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	request := CreateSongRequest{
+		Group: defaultSong.Group,
+		Song:  strings.Repeat("a", 256),
+	}
+
+	_, code, err := songServiceClient.CreateSongAsync(request)
+
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	var problem Problem
+
+	require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+	assert.Equal(t, []FieldError{{Field: "song", Code: "too_long", Message: "must be at most 255 characters long"}}, problem.Errors)
+}
+
+func TestExpiredSongJob(t *testing.T) {
+	if err := SetUpCreateTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		attempts       int32
+		expectedStatus string
+	}{
+		{
+			name:           "attempts left",
+			attempts:       1,
+			expectedStatus: "succeeded",
+		},
+		{
+			name:           "last attempt",
+			attempts:       3,
+			expectedStatus: "dead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobID, err := songServiceDB.CreateExpiredSongJob(defaultSong.Group, defaultSong.Name, tt.attempts, 3)
+			require.Nil(t, err)
+
+			job := waitSongJob(t, jobID)
+
+			assert.Equal(t, tt.expectedStatus, job.Status)
+
+			if tt.expectedStatus == "dead" {
+				assert.Equal(t, tt.attempts, job.Attempts)
+			}
+		})
+	}
+}
+
+// waitSongJob polls the job until it is succeeded or dead.
+func waitSongJob(t *testing.T, id uuid.UUID) SongJob {
+	t.Helper()
+
+	deadline := time.Now().Add(songJobWaitTimeout)
+
+	for time.Now().Before(deadline) {
+		resp, _, err := songServiceClient.GetSongJob(id)
+		require.Nil(t, err)
+
+		if resp.Job.Status == "succeeded" || resp.Job.Status == "dead" {
+			return resp.Job
+		}
+
+		time.Sleep(songJobPollInterval)
+	}
+
+	t.Fatalf("job %s is not finished in %s", id, songJobWaitTimeout)
+
+	return SongJob{}
+}
@@ -0,0 +1,227 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	contentTypeMergePatch = "application/merge-patch+json"
+	contentTypeJSONPatch  = "application/json-patch+json"
+)
+
+func TestMergePatch(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	patch := map[string]any{
+		"group": "new-song-group",
+		"text":  "",
+		"link":  nil,
+	}
+
+	expectedSong := defaultSong
+	expectedSong.Group = "new-song-group"
+	expectedSong.Text = ""
+	expectedSong.Link = ""
+
+	resp, code, err := songServiceClient.PatchSong(defaultSong.ID, contentTypeMergePatch, patch)
+
+	require.Nil(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, expectedSong, resp.Song)
+
+	song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+	require.Nil(t, err)
+	assert.Equal(t, expectedSong, song)
+}
+
+func TestJSONPatch(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	patch := []map[string]any{
+		{"op": "test", "path": "/text", "value": defaultSong.Text},
+		{"op": "replace", "path": "/text", "value": "new-song-text"},
+		{"op": "copy", "from": "/group", "path": "/song"},
+		{"op": "remove", "path": "/link"},
+	}
+
+	expectedSong := defaultSong
+	expectedSong.Name = defaultSong.Group
+	expectedSong.Text = "new-song-text"
+	expectedSong.Link = ""
+
+	resp, code, err := songServiceClient.PatchSong(defaultSong.ID, contentTypeJSONPatch, patch)
+
+	require.Nil(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, expectedSong, resp.Song)
+}
+
+func TestPatchErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		contentType    string
+		patch          any
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "unknown operation",
+			contentType:    contentTypeJSONPatch,
+			patch:          []map[string]any{{"op": "rename", "path": "/text"}},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "invalid_request",
+		},
+		{
+			name:           "failed test",
+			contentType:    contentTypeJSONPatch,
+			patch:          []map[string]any{{"op": "test", "path": "/text", "value": "other-text"}, {"op": "remove", "path": "/link"}},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "patch_conflict",
+		},
+		{
+			name:           "missing path",
+			contentType:    contentTypeJSONPatch,
+			patch:          []map[string]any{{"op": "replace", "path": "/lyrics", "value": "text"}},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "patch_conflict",
+		},
+		{
+			name:           "unknown field",
+			contentType:    contentTypeJSONPatch,
+			patch:          []map[string]any{{"op": "add", "path": "/lyrics", "value": "text"}},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "validation_failed",
+		},
+		{
+			name:           "cleared name",
+			contentType:    contentTypeMergePatch,
+			patch:          map[string]any{"song": nil},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "validation_failed",
+		},
+		{
+			name:           "invalid type",
+			contentType:    contentTypeMergePatch,
+			patch:          map[string]any{"text": 42},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "validation_failed",
+		},
+		{
+			name:           "unsupported media type",
+			contentType:    "text/plain",
+			patch:          map[string]any{"text": "new-song-text"},
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedCode:   "unsupported_media_type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpDefault(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, code, err := songServiceClient.PatchSong(defaultSong.ID, tt.contentType, tt.patch)
+
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedStatus, code)
+
+			var problem Problem
+
+			require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+			assert.Equal(t, tt.expectedCode, problem.Code)
+
+			song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+			require.Nil(t, err)
+			assert.Equal(t, defaultSong, song)
+		})
+	}
+}
+
+func TestReplace(t *testing.T) {
+	fullRequest := func() map[string]any {
+		return map[string]any{
+			"song":         defaultSong.Name,
+			"group":        defaultSong.Group,
+			"release_date": defaultSong.ReleaseDate.String(),
+			"text":         defaultSong.Text,
+			"link":         defaultSong.Link,
+		}
+	}
+
+	t.Run("clear fields", func(t *testing.T) {
+		if err := SetUpDefault(); err != nil {
+			t.Fatal(err)
+		}
+
+		request := fullRequest()
+		request["text"] = " "
+		request["link"] = ""
+
+		_, code, err := songServiceClient.ReplaceSong(defaultSong.ID, request)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, code)
+
+		var problem Problem
+
+		require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+		assert.Equal(t, []FieldError{
+			{Field: "text", Code: "required", Message: "must not be empty"},
+			{Field: "link", Code: "required", Message: "must not be empty"},
+		}, problem.Errors)
+
+		song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, defaultSong, song)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		if err := SetUpDefault(); err != nil {
+			t.Fatal(err)
+		}
+
+		request := fullRequest()
+		delete(request, "link")
+
+		_, code, err := songServiceClient.ReplaceSong(defaultSong.ID, request)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		if err := SetUpDefault(); err != nil {
+			t.Fatal(err)
+		}
+
+		request := fullRequest()
+		request["lyrics"] = "text"
+
+		_, code, err := songServiceClient.ReplaceSong(defaultSong.ID, request)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		var problem Problem
+
+		require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+		assert.Equal(t, []FieldError{{Field: "lyrics", Code: "unknown_field", Message: "is not allowed"}}, problem.Errors)
+	})
+}
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hardfinhq/go-date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var outdatedSong = Song{
+	ID:          defaultSong.ID,
+	Group:       defaultSong.Group,
+	Name:        defaultSong.Name,
+	ReleaseDate: date.NewDate(2024, 1, 1),
+	Text:        "outdated-song-text",
+	Link:        defaultSong.Link,
+}
+
+func TestRefreshNonExistentSong(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, code, err := songServiceClient.RefreshSong(nonExistentSong.ID, nil)
+
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestRefreshSong(t *testing.T) {
+	expectedChanges := []FieldChange{
+		{Field: "release_date", From: outdatedSong.ReleaseDate.String(), To: defaultSong.ReleaseDate.String()},
+		{Field: "text", From: outdatedSong.Text, To: defaultSong.Text},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		if err := SetUp([]Song{defaultSong}, []Song{outdatedSong}); err != nil {
+			t.Fatal(err)
+		}
+
+		resp, code, err := songServiceClient.RefreshSong(outdatedSong.ID, RefreshSongQueryParams{DryRun: true})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, resp.Refresh.Applied)
+		assert.Equal(t, expectedChanges, resp.Refresh.Changes)
+		assert.Equal(t, outdatedSong, resp.Refresh.Song)
+
+		song, err := songServiceDB.GetSongByID(outdatedSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, outdatedSong, song)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		if err := SetUp([]Song{defaultSong}, []Song{outdatedSong}); err != nil {
+			t.Fatal(err)
+		}
+
+		resp, code, err := songServiceClient.RefreshSong(outdatedSong.ID, nil)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, resp.Refresh.Applied)
+		assert.Equal(t, expectedChanges, resp.Refresh.Changes)
+		assert.Equal(t, defaultSong, resp.Refresh.Song)
+
+		song, err := songServiceDB.GetSongByID(outdatedSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, defaultSong, song)
+
+		listResp, _, err := songServiceClient.ListSongRevision(outdatedSong.ID)
+
+		require.Nil(t, err)
+		require.NotEmpty(t, listResp.RevisionList)
+		assert.Equal(t, "refresh", listResp.RevisionList[len(listResp.RevisionList)-1].Operation)
+
+		resp, code, err = songServiceClient.RefreshSong(outdatedSong.ID, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, resp.Refresh.Applied)
+		assert.Empty(t, resp.Refresh.Changes)
+	})
+
+	t.Run("not found upstream", func(t *testing.T) {
+		if err := SetUp(nil, []Song{outdatedSong}); err != nil {
+			t.Fatal(err)
+		}
+
+		_, code, err := songServiceClient.RefreshSong(outdatedSong.ID, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("invalid upstream", func(t *testing.T) {
+		invalidSong := defaultSong
+		invalidSong.Link = "invalid-song-link"
+
+		if err := SetUp([]Song{invalidSong}, []Song{outdatedSong}); err != nil {
+			t.Fatal(err)
+		}
+
+		_, code, err := songServiceClient.RefreshSong(outdatedSong.ID, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadGateway, code)
+
+		var problem Problem
+
+		require.Nil(t, json.Unmarshal([]byte(err.Error()), &problem))
+		assert.Equal(t, "upstream_failed", problem.Code)
+		assert.Empty(t, problem.Errors)
+
+		song, err := songServiceDB.GetSongByID(outdatedSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, outdatedSong, song)
+	})
+}
+
+func TestRefreshSongs(t *testing.T) {
+	var (
+		currentSong = Song{
+			ID:          uuid.New(),
+			Group:       defaultSong.Group,
+			Name:        "current-song-name",
+			ReleaseDate: date.NewDate(2023, 1, 1),
+			Text:        "current-song-text",
+			Link:        "https://example.com/current-song-link",
+		}
+		removedSong = Song{
+			ID:          uuid.New(),
+			Group:       defaultSong.Group,
+			Name:        "removed-song-name",
+			ReleaseDate: date.NewDate(2022, 1, 1),
+			Text:        "removed-song-text",
+			Link:        "https://example.com/removed-song-link",
+		}
+		otherSong = Song{
+			ID:          uuid.New(),
+			Group:       "other-group",
+			Name:        "other-song-name",
+			ReleaseDate: date.NewDate(2021, 1, 1),
+			Text:        "other-song-text",
+			Link:        "https://example.com/other-song-link",
+		}
+	)
+
+	statuses := func(resp *RefreshSongsResponse) map[uuid.UUID]string {
+		statuses := make(map[uuid.UUID]string)
+		for _, result := range resp.Results {
+			statuses[result.ID] = result.Status
+		}
+
+		return statuses
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		expectedStatus := "updated"
+		if dryRun {
+			expectedStatus = "outdated"
+		}
+
+		t.Run(expectedStatus, func(t *testing.T) {
+			if err := SetUp([]Song{defaultSong, currentSong, otherSong}, []Song{outdatedSong, currentSong, removedSong, otherSong}); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, code, err := songServiceClient.RefreshSongs(RefreshSongsQueryParams{Group: []string{defaultSong.Group}, DryRun: dryRun})
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, map[uuid.UUID]string{
+				outdatedSong.ID: expectedStatus,
+				currentSong.ID:  "unchanged",
+				removedSong.ID:  "not_found",
+			}, statuses(resp))
+
+			song, err := songServiceDB.GetSongByID(outdatedSong.ID)
+
+			require.Nil(t, err)
+
+			if dryRun {
+				assert.Equal(t, outdatedSong, song)
+			} else {
+				assert.Equal(t, defaultSong, song)
+			}
+		})
+	}
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, code, err := songServiceClient.RefreshSongs(RefreshSongsQueryParams{Limit: 1001})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
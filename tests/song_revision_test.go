@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNonExistentSongRevisions(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, code, err := songServiceClient.ListSongRevision(nonExistentSong.ID)
+
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestSongRevisions(t *testing.T) {
+	if err := SetUpCreateTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	createResp, code, err := songServiceClient.CreateSong(CreateSongRequest{Group: defaultSong.Group, Song: defaultSong.Name}, nil)
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	song := createResp.Song
+	newText := song.Text + "\n\nnew-verse"
+
+	_, code, err = songServiceClient.PartialUpdateSong(song.ID, UpdateSongRequest{Text: newText, Link: "https://example.com/new-link"}, nil)
+
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	t.Run("list", func(t *testing.T) {
+		resp, code, err := songServiceClient.ListSongRevision(song.ID)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		require.Equal(t, 2, len(resp.RevisionList))
+		assert.Equal(t, "create", resp.RevisionList[0].Operation)
+		assert.Equal(t, "update", resp.RevisionList[1].Operation)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSongRevision(song.ID, 1)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, song, resp.Revision.Song)
+	})
+
+	t.Run("get non-existent", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongRevision(song.ID, 10)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		resp, code, err := songServiceClient.DiffSongRevision(song.ID, 1, 2)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []FieldChange{
+			{Field: "text", From: song.Text, To: newText},
+			{Field: "link", From: song.Link, To: "https://example.com/new-link"},
+		}, resp.Diff.Fields)
+		assert.Equal(t, []VerseChange{
+			{Operation: "equal", Text: song.Text},
+			{Operation: "insert", Text: "new-verse"},
+		}, resp.Diff.Verses)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		_, header, _, err := songServiceClient.GetSongIfNoneMatch(song.ID, "")
+
+		require.Nil(t, err)
+
+		etag := header.Get("ETag")
+
+		resp, code, err := songServiceClient.RevertSong(song.ID, 1, etag)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, song, resp.Song)
+
+		_, code, err = songServiceClient.RevertSong(song.ID, 2, etag)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+
+		listResp, _, err := songServiceClient.ListSongRevision(song.ID)
+
+		require.Nil(t, err)
+		require.Equal(t, 3, len(listResp.RevisionList))
+		assert.Equal(t, "revert", listResp.RevisionList[2].Operation)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, code, err := songServiceClient.DeleteSong(song.ID, nil)
+
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		_, _, err = songServiceClient.DeleteSong(song.ID, nil)
+		require.Nil(t, err)
+
+		listResp, _, err := songServiceClient.ListSongRevision(song.ID)
+
+		require.Nil(t, err)
+		require.Equal(t, 4, len(listResp.RevisionList))
+		assert.Equal(t, "delete", listResp.RevisionList[3].Operation)
+	})
+}
@@ -29,6 +29,18 @@ func (c *SongServiceClient) CreateSong(request CreateSongRequest, queryParams an
 	return makeRequest[CreateSongRequest, CreateSongResponse](c.client, c.baseURL, "/songs", http.MethodPost, &request, queryParams)
 }
 
+func (c *SongServiceClient) CreateSongBatch(request CreateSongBatchRequest, queryParams any) (*CreateSongBatchResponse, int, error) {
+	return makeRequest[CreateSongBatchRequest, CreateSongBatchResponse](c.client, c.baseURL, "/songs/batch", http.MethodPost, &request, queryParams)
+}
+
+func (c *SongServiceClient) CreateSongAsync(request CreateSongRequest) (*SongJobResponse, int, error) {
+	return makeRequest[CreateSongRequest, SongJobResponse](c.client, c.baseURL, "/songs", http.MethodPost, &request, CreateSongQueryParams{Async: true})
+}
+
+func (c *SongServiceClient) GetSongJob(id uuid.UUID) (*SongJobResponse, int, error) {
+	return makeRequest[struct{}, SongJobResponse](c.client, c.baseURL, fmt.Sprintf("/jobs/%s", id.String()), http.MethodGet, nil, nil)
+}
+
 func (c *SongServiceClient) UpdateSong(id uuid.UUID, request UpdateSongRequest, queryParams any) (*UpdateSongResponse, int, error) {
 	return makeRequest[UpdateSongRequest, UpdateSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodPut, &request, queryParams)
 }
@@ -37,22 +49,194 @@ func (c *SongServiceClient) PartialUpdateSong(id uuid.UUID, request UpdateSongRe
 	return makeRequest[UpdateSongRequest, UpdateSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodPatch, &request, queryParams)
 }
 
+func (c *SongServiceClient) ReplaceSong(id uuid.UUID, request map[string]any) (*UpdateSongResponse, int, error) {
+	return makeRequest[map[string]any, UpdateSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodPut, &request, nil)
+}
+
+func (c *SongServiceClient) PatchSong(id uuid.UUID, contentType string, patch any) (*UpdateSongResponse, int, error) {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	response, _, code, err := makeRequestWithHeaders[any, UpdateSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodPatch, &patch, nil, header)
+
+	return response, code, err
+}
+
 func (c *SongServiceClient) DeleteSong(id uuid.UUID, queryParams any) (*DeleteSongResponse, int, error) {
 	return makeRequest[struct{}, DeleteSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodDelete, nil, queryParams)
 }
 
+func (c *SongServiceClient) ListDeletedSong(queryParams any) (*ListDeletedSongResponse, int, error) {
+	return makeRequest[struct{}, ListDeletedSongResponse](c.client, c.baseURL, "/songs/trash", http.MethodGet, nil, queryParams)
+}
+
+func (c *SongServiceClient) RestoreSong(id uuid.UUID) (*SongResponse, int, error) {
+	return makeRequest[struct{}, SongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/restore", id.String()), http.MethodPost, nil, nil)
+}
+
+func (c *SongServiceClient) ListSongRevision(id uuid.UUID) (*SongRevisionsResponse, int, error) {
+	return makeRequest[struct{}, SongRevisionsResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/revisions", id.String()), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) GetSongRevision(id uuid.UUID, revision int32) (*SongRevisionResponse, int, error) {
+	return makeRequest[struct{}, SongRevisionResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/revisions/%d", id.String(), revision), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) DiffSongRevision(id uuid.UUID, from int32, to int32) (*SongRevisionDiffResponse, int, error) {
+	return makeRequest[struct{}, SongRevisionDiffResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/revisions/%d/diff/%d", id.String(), from, to), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) RevertSong(id uuid.UUID, revision int32, etag string) (*SongResponse, int, error) {
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	response, _, code, err := makeRequestWithHeaders[struct{}, SongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/revisions/%d/revert", id.String(), revision), http.MethodPost, nil, nil, header)
+
+	return response, code, err
+}
+
+func (c *SongServiceClient) RefreshSong(id uuid.UUID, queryParams any) (*RefreshSongResponse, int, error) {
+	return makeRequest[struct{}, RefreshSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/refresh", id.String()), http.MethodPost, nil, queryParams)
+}
+
+func (c *SongServiceClient) RefreshSongs(queryParams any) (*RefreshSongsResponse, int, error) {
+	return makeRequest[struct{}, RefreshSongsResponse](c.client, c.baseURL, "/songs/refresh", http.MethodPost, nil, queryParams)
+}
+
 func (c *SongServiceClient) GetSong(id uuid.UUID, queryParams any) (*SongResponse, int, error) {
 	return makeRequest[struct{}, SongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodGet, nil, queryParams)
 }
 
+func (c *SongServiceClient) GetSongVerses(id uuid.UUID, queryParams any) (*SongVersesResponse, int, error) {
+	return makeRequest[struct{}, SongVersesResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/verses", id.String()), http.MethodGet, nil, queryParams)
+}
+
+func (c *SongServiceClient) GetSongVerse(id uuid.UUID, n int, queryParams any) (*SongVerseResponse, int, error) {
+	return makeRequest[struct{}, SongVerseResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/verses/%d", id.String(), n), http.MethodGet, nil, queryParams)
+}
+
+func (c *SongServiceClient) GetSongAcceptLanguage(id uuid.UUID, queryParams any, acceptLanguage string) (*SongResponse, http.Header, int, error) {
+	header := http.Header{}
+	if acceptLanguage != "" {
+		header.Set("Accept-Language", acceptLanguage)
+	}
+
+	return makeRequestWithHeaders[struct{}, SongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodGet, nil, queryParams, header)
+}
+
+func (c *SongServiceClient) ListSongTranslations(id uuid.UUID) (*SongTranslationsResponse, int, error) {
+	return makeRequest[struct{}, SongTranslationsResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/translations", id.String()), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) GetSongTranslation(id uuid.UUID, lang string) (*SongTranslationResponse, int, error) {
+	return makeRequest[struct{}, SongTranslationResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/translations/%s", id.String(), lang), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) UpdateSongTranslation(id uuid.UUID, lang string, request UpdateSongTranslationRequest, etag string) (*SongTranslationResponse, http.Header, int, error) {
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	return makeRequestWithHeaders[UpdateSongTranslationRequest, SongTranslationResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/translations/%s", id.String(), lang), http.MethodPut, &request, nil, header)
+}
+
+func (c *SongServiceClient) DeleteSongTranslation(id uuid.UUID, lang string) (*SongTranslationResponse, int, error) {
+	return makeRequest[struct{}, SongTranslationResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s/translations/%s", id.String(), lang), http.MethodDelete, nil, nil)
+}
+
+func (c *SongServiceClient) UploadSyncedLyrics(id uuid.UUID, contentType string, lrc string, etag string) (*UpdateSyncedLyricsResponse, int, error) {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	body, _, code, err := makeRawRequest(c.client, c.baseURL, fmt.Sprintf("/songs/%s/lyrics/synced", id.String()), http.MethodPut, lrc, header)
+	if err != nil {
+		return nil, code, err
+	}
+
+	var response UpdateSyncedLyricsResponse
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, code, err
+	}
+
+	return &response, code, nil
+}
+
+func (c *SongServiceClient) GetSyncedLyrics(id uuid.UUID, accept string) ([]byte, http.Header, int, error) {
+	header := http.Header{}
+	if accept != "" {
+		header.Set("Accept", accept)
+	}
+
+	return makeRawRequest(c.client, c.baseURL, fmt.Sprintf("/songs/%s/lyrics/synced", id.String()), http.MethodGet, "", header)
+}
+
+func (c *SongServiceClient) GetSongIfNoneMatch(id uuid.UUID, etag string) (*SongResponse, http.Header, int, error) {
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-None-Match", etag)
+	}
+
+	return makeRequestWithHeaders[struct{}, SongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodGet, nil, nil, header)
+}
+
+func (c *SongServiceClient) PartialUpdateSongIfMatch(id uuid.UUID, request UpdateSongRequest, etag string) (*UpdateSongResponse, http.Header, int, error) {
+	header := http.Header{}
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	return makeRequestWithHeaders[UpdateSongRequest, UpdateSongResponse](c.client, c.baseURL, fmt.Sprintf("/songs/%s", id.String()), http.MethodPatch, &request, nil, header)
+}
+
 func (c *SongServiceClient) ListSong(queryParams any) (*ListSongResponse, int, error) {
 	return makeRequest[struct{}, ListSongResponse](c.client, c.baseURL, "/songs", http.MethodGet, nil, queryParams)
 }
 
+func (c *SongServiceClient) SearchSong(queryParams any) (*SearchSongResponse, int, error) {
+	return makeRequest[struct{}, SearchSongResponse](c.client, c.baseURL, "/songs", http.MethodGet, nil, queryParams)
+}
+
+func (c *SongServiceClient) CreateGroup(request CreateGroupRequest) (*GroupResponse, int, error) {
+	return makeRequest[CreateGroupRequest, GroupResponse](c.client, c.baseURL, "/groups", http.MethodPost, &request, nil)
+}
+
+func (c *SongServiceClient) GetGroup(id uuid.UUID) (*GroupResponse, int, error) {
+	return makeRequest[struct{}, GroupResponse](c.client, c.baseURL, fmt.Sprintf("/groups/%s", id.String()), http.MethodGet, nil, nil)
+}
+
+func (c *SongServiceClient) ListGroup(queryParams any) (*ListGroupResponse, int, error) {
+	return makeRequest[struct{}, ListGroupResponse](c.client, c.baseURL, "/groups", http.MethodGet, nil, queryParams)
+}
+
+func (c *SongServiceClient) RenameGroup(id uuid.UUID, request RenameGroupRequest) (*GroupResponse, int, error) {
+	return makeRequest[RenameGroupRequest, GroupResponse](c.client, c.baseURL, fmt.Sprintf("/groups/%s", id.String()), http.MethodPatch, &request, nil)
+}
+
+func (c *SongServiceClient) DeleteGroup(id uuid.UUID) (*DeleteGroupResponse, int, error) {
+	return makeRequest[struct{}, DeleteGroupResponse](c.client, c.baseURL, fmt.Sprintf("/groups/%s", id.String()), http.MethodDelete, nil, nil)
+}
+
+func (c *SongServiceClient) RestoreGroup(id uuid.UUID) (*GroupResponse, int, error) {
+	return makeRequest[struct{}, GroupResponse](c.client, c.baseURL, fmt.Sprintf("/groups/%s/restore", id.String()), http.MethodPost, nil, nil)
+}
+
 func makeRequest[Req any, Resp any](client *http.Client, baseURL string, endpoint string, method string, request *Req, queryParams any) (*Resp, int, error) {
+	response, _, code, err := makeRequestWithHeaders[Req, Resp](client, baseURL, endpoint, method, request, queryParams, nil)
+
+	return response, code, err
+}
+
+func makeRequestWithHeaders[Req any, Resp any](client *http.Client, baseURL string, endpoint string, method string, request *Req, queryParams any, header http.Header) (*Resp, http.Header, int, error) {
 	url, err := buildURL(baseURL, endpoint, queryParams)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 
 	var body io.Reader
@@ -60,7 +244,7 @@ func makeRequest[Req any, Resp any](client *http.Client, baseURL string, endpoin
 	if request != nil {
 		requestBytes, err := json.Marshal(request)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 
 		body = bytes.NewBuffer(requestBytes)
@@ -68,36 +252,69 @@ func makeRequest[Req any, Resp any](client *http.Client, baseURL string, endpoin
 
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
+	}
+
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
 	}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, resp.StatusCode, err
+			return nil, resp.Header, resp.StatusCode, err
 		}
 
-		return nil, resp.StatusCode, errors.New(string(body))
+		return nil, resp.Header, resp.StatusCode, errors.New(string(body))
 	}
 
 	responseBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 
 	var response Resp
 
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
+	}
+
+	return &response, resp.Header, resp.StatusCode, nil
+}
+
+// makeRawRequest sends the body as is and returns the response body without decoding it.
+func makeRawRequest(client *http.Client, baseURL string, endpoint string, method string, body string, header http.Header) ([]byte, http.Header, int, error) {
+	req, err := http.NewRequest(method, baseURL+endpoint, bytes.NewBufferString(body))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	req.Header = header
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.Header, resp.StatusCode, err
 	}
 
-	return &response, resp.StatusCode, nil
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.Header, resp.StatusCode, errors.New(string(responseBytes))
+	}
+
+	return responseBytes, resp.Header, resp.StatusCode, nil
 }
 
 func buildURL(baseURL string, endpoint string, queryParams any) (string, error) {
@@ -122,3 +339,11 @@ func buildURL(baseURL string, endpoint string, queryParams any) (string, error)
 
 	return parsedURL.String(), nil
 }
+
+func (c *SongServiceClient) Purge() (*PurgeResponse, int, error) {
+	return makeRequest[struct{}, PurgeResponse](c.client, c.baseURL, "/admin/purge", http.MethodPost, nil, nil)
+}
+
+func (c *SongServiceClient) Health() (*HealthResponse, int, error) {
+	return makeRequest[struct{}, HealthResponse](c.client, c.baseURL, "/health", http.MethodGet, nil, nil)
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"song-service/internal/infrastructure/database/postgres"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -21,7 +22,7 @@ func (d *SongServiceDatabase) CreateGroup(name string) (uuid.UUID, error) {
 	const query = `
 		INSERT INTO groups (name)
 		VALUES ($1)
-		ON CONFLICT (name) 
+		ON CONFLICT (name) WHERE deleted_at IS NULL
 		DO UPDATE 
 		SET name = EXCLUDED.name
 		RETURNING id;
@@ -43,12 +44,12 @@ func (d *SongServiceDatabase) CreateSong(song Song) (Song, error) {
 	}
 
 	const query = `
-		INSERT INTO songs (id, name, group_id, release_date, text, link)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO songs (id, name, group_id, release_date, text, link, language)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id;
 	`
 
-	if err := d.db.QueryRow(context.Background(), query, song.ID, song.Name, groupID, song.ReleaseDate, song.Text, song.Link).Scan(&song.ID); err != nil {
+	if err := d.db.QueryRow(context.Background(), query, song.ID, song.Name, groupID, song.ReleaseDate, song.Text, song.Link, song.Language).Scan(&song.ID); err != nil {
 		return Song{}, err
 	}
 
@@ -81,6 +82,49 @@ func (d *SongServiceDatabase) GetSongByID(songID uuid.UUID) (Song, error) {
 	return song, nil
 }
 
+func (d *SongServiceDatabase) GetSongStructure(songID uuid.UUID) ([]VerseStructure, error) {
+	const query = `
+		SELECT structure FROM songs WHERE id = $1;
+	`
+
+	var structure []VerseStructure
+
+	if err := d.db.QueryRow(context.Background(), query, songID).Scan(&structure); err != nil {
+		return nil, err
+	}
+
+	return structure, nil
+}
+
+func (d *SongServiceDatabase) DeleteSong(songID uuid.UUID, deletedAt time.Time) error {
+	const query = `
+		UPDATE songs SET deleted_at = $2 WHERE id = $1;
+	`
+
+	if _, err := d.db.Exec(context.Background(), query, songID, deletedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateExpiredSongJob creates a running job whose lease has expired after the given attempts.
+func (d *SongServiceDatabase) CreateExpiredSongJob(group string, song string, attempts int32, maxAttempts int32) (uuid.UUID, error) {
+	const query = `
+		INSERT INTO song_jobs (group_name, name, status, attempts, max_attempts, locked_until)
+		VALUES ($1, $2, 'running', $3, $4, NOW() - INTERVAL '1 minute')
+		RETURNING id;
+	`
+
+	var jobID uuid.UUID
+
+	if err := d.db.QueryRow(context.Background(), query, group, song, attempts, maxAttempts).Scan(&jobID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return jobID, nil
+}
+
 func (d *SongServiceDatabase) Truncate(ctx context.Context) error {
 	query := `
 		DO $$ DECLARE
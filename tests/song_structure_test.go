@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hardfinhq/go-date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSongStructure(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		releaseDate = date.NewDate(2021, 2, 3)
+		text        = "verse-1 line\n\nOh oh, sing it loud\nAll night long\n\nverse-2 line\n\noh oh sing it loud!\nall night long\n\nOh oh, sing it so loud\nAll the night long"
+		link        = "https://example.com/song-link"
+	)
+
+	request := CreateSongRequest{
+		Group:       "song-group",
+		Song:        "song-name",
+		ReleaseDate: &releaseDate,
+		Text:        &text,
+		Link:        &link,
+	}
+
+	resp, code, err := songServiceClient.CreateSong(request, nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, http.StatusOK, code)
+
+	songID := resp.Song.ID
+
+	t.Run("persisted on create", func(t *testing.T) {
+		structure, err := songServiceDB.GetSongStructure(songID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []VerseStructure{
+			{Type: "verse"},
+			{Type: "chorus"},
+			{Type: "verse"},
+			{Type: "chorus", RepeatOf: repeatOf(1)},
+			{Type: "chorus", RepeatOf: repeatOf(1)},
+		}, structure)
+	})
+
+	t.Run("verses", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(songID, SongQueryParams{Verses: true, Offset: 3, Limit: 1})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []Verse{
+			{Index: 3, Type: "chorus", Lines: []string{"oh oh sing it loud!", "all night long"}, RepeatOf: repeatOf(1)},
+		}, resp.Verses)
+	})
+
+	t.Run("collapse repeats", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(songID, SongQueryParams{CollapseRepeats: true})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "verse-1 line\n\nOh oh, sing it loud\nAll night long\n\nverse-2 line\n\n[Chorus]\n\n[Chorus]", resp.Song.Text)
+		require.NotNil(t, resp.VerseTotal)
+		assert.Equal(t, 5, *resp.VerseTotal)
+	})
+
+	t.Run("collapse repeats verses", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSongVerses(songID, SongVersesQueryParams{Offset: 4, CollapseRepeats: true})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []Verse{{Index: 4, Type: "chorus", Label: "[Chorus]", Lines: []string{}, RepeatOf: repeatOf(1)}}, resp.Verses)
+	})
+
+	t.Run("persisted on update", func(t *testing.T) {
+		patch := map[string]any{"text": "[Chorus]\nla la\n\nverse line\n\n[Chorus]"}
+
+		_, code, err := songServiceClient.PatchSong(songID, contentTypeMergePatch, patch)
+
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		structure, err := songServiceDB.GetSongStructure(songID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []VerseStructure{
+			{Type: "chorus"},
+			{Type: "verse"},
+			{Type: "chorus", RepeatOf: repeatOf(0)},
+		}, structure)
+	})
+}
+
+func TestSongStructureNotPersisted(t *testing.T) {
+	song := Song{
+		ID:          uuid.New(),
+		Group:       "song-group",
+		Name:        "song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "chorus line\n\nverse line\n\nchorus line",
+		Link:        "https://example.com/song-link",
+	}
+
+	if err := SetUp([]Song{song}, []Song{song}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, code, err := songServiceClient.GetSongVerse(song.ID, 2, nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, Verse{Index: 2, Type: "chorus", Lines: []string{"chorus line"}, RepeatOf: repeatOf(0)}, resp.Verse)
+}
+
+func repeatOf(index int) *int {
+	return &index
+}
@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	contentTypeLRC = "text/x-lrc"
+
+	syncedLyricsLRC = "[ar:song-group]\n[offset:500]\n[00:12.00]First line\n[00:15.50][00:30.00]Chorus line\n[00:20.00]\n[00:21.00]<00:21.00>Hello <00:21.50>world\n"
+)
+
+func TestUploadSyncedLyrics(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, syncedLyricsLRC, "")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	expectedText := "First line\nChorus line\n\nHello world\nChorus line"
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, expectedText, resp.Song.Text)
+	assert.Equal(t, SyncedLyrics{
+		Tags: map[string]string{"ar": "song-group"},
+		Lines: []SyncedLine{
+			{Time: 11500, Text: "First line"},
+			{Time: 15000, Text: "Chorus line"},
+			{Time: 19500, Text: ""},
+			{Time: 20500, Text: "Hello world", Words: []SyncedWord{{Time: 20500, Text: "Hello "}, {Time: 21000, Text: "world"}}},
+			{Time: 29500, Text: "Chorus line"},
+		},
+	}, resp.Lyrics)
+
+	song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedText, song.Text)
+
+	t.Run("json", func(t *testing.T) {
+		body, header, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, header.Get("Content-Type"), "application/json")
+
+		var response SyncedLyricsResponse
+
+		require.NoError(t, json.Unmarshal(body, &response))
+		assert.Equal(t, resp.Lyrics, response.Lyrics)
+	})
+
+	t.Run("lrc", func(t *testing.T) {
+		body, header, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, contentTypeLRC)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, header.Get("Content-Type"), contentTypeLRC)
+		assert.Equal(t, "[ar:song-group]\n[00:11.50]First line\n[00:15.00]Chorus line\n[00:19.50]\n[00:20.50]<00:20.50>Hello <00:21.00>world\n[00:29.50]Chorus line\n", string(body))
+	})
+
+	t.Run("webvtt", func(t *testing.T) {
+		body, header, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, "text/vtt")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Contains(t, header.Get("Content-Type"), "text/vtt")
+		assert.Equal(t, "WEBVTT\n\n"+
+			"00:00:11.500 --> 00:00:15.000\nFirst line\n\n"+
+			"00:00:15.000 --> 00:00:19.500\nChorus line\n\n"+
+			"00:00:20.500 --> 00:00:29.500\nHello <00:00:21.000>world\n\n"+
+			"00:00:29.500 --> 00:00:34.500\nChorus line\n", string(body))
+	})
+
+	t.Run("not acceptable", func(t *testing.T) {
+		_, _, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, "application/xml")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotAcceptable, code)
+	})
+}
+
+func TestUploadSyllableSyncedLyrics(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	lrc := "[00:01.00]<00:01.00>Hel<00:01.20>lo <00:01.50>world\n"
+
+	resp, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, lrc, "")
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Hello world", resp.Song.Text)
+	assert.Equal(t, []SyncedLine{
+		{Time: 1000, Text: "Hello world", Words: []SyncedWord{{Time: 1000, Text: "Hel"}, {Time: 1200, Text: "lo "}, {Time: 1500, Text: "world"}}},
+	}, resp.Lyrics.Lines)
+
+	body, _, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, contentTypeLRC)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, lrc, string(body))
+}
+
+func TestUploadSyncedLyricsIfMatch(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, syncedLyricsLRC, "")
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	_, header, _, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, "")
+
+	require.NoError(t, err)
+
+	etag := header.Get("ETag")
+
+	// Same text with different timings.
+	retimedLRC := "[00:13.00]First line\n[00:16.00][00:31.00]Chorus line\n[00:21.00]\n[00:22.00]Hello world\n"
+
+	resp, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, retimedLRC, etag)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusOK, code)
+
+	revisions, _, err := songServiceClient.ListSongRevision(defaultSong.ID)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, revisions.RevisionList)
+	assert.Equal(t, "synced_lyrics", revisions.RevisionList[len(revisions.RevisionList)-1].Operation)
+
+	_, code, err = songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, syncedLyricsLRC, etag)
+
+	require.Error(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, code)
+}
+
+func TestUploadSyncedLyricsErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		contentType    string
+		lrc            string
+		expectedStatus int
+		expectedErrors []FieldError
+	}{
+		{
+			name:           "invalid lines",
+			contentType:    contentTypeLRC,
+			lrc:            "[00:01.00]first\nno timestamp\n[00:75.00]bad seconds\n[00:05.00]<00:04.00>early word",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErrors: []FieldError{
+				{Field: "lyrics", Code: "missing_timestamp", Message: "line 2: must start with a time tag"},
+				{Field: "lyrics", Code: "invalid_timestamp", Message: "line 3: has an invalid time tag"},
+				{Field: "lyrics", Code: "invalid_timestamp", Message: "line 4: has invalid or decreasing word timestamps"},
+			},
+		},
+		{
+			name:           "no timed lines",
+			contentType:    contentTypeLRC,
+			lrc:            "[ar:song-group]",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErrors: []FieldError{
+				{Field: "lyrics", Code: "required", Message: "must contain at least one timed line"},
+			},
+		},
+		{
+			name:           "unsupported media type",
+			contentType:    "application/json",
+			lrc:            syncedLyricsLRC,
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpDefault(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, tt.contentType, tt.lrc, "")
+
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedStatus, code)
+
+			var problem Problem
+
+			require.NoError(t, json.Unmarshal([]byte(err.Error()), &problem))
+			assert.Equal(t, tt.expectedErrors, problem.Errors)
+
+			song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, defaultSong, song)
+		})
+	}
+}
+
+func TestSyncedLyricsNotFound(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("song without synced lyrics", func(t *testing.T) {
+		_, _, code, err := songServiceClient.GetSyncedLyrics(defaultSong.ID, "")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("unknown song", func(t *testing.T) {
+		_, code, err := songServiceClient.UploadSyncedLyrics(uuid.New(), contentTypeLRC, syncedLyricsLRC, "")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("text changed", func(t *testing.T) {
+		_, code, err := songServiceClient.UploadSyncedLyrics(defaultSong.ID, contentTypeLRC, syncedLyricsLRC, "")
+
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		_, code, err = songServiceClient.PatchSong(defaultSong.ID, contentTypeMergePatch, map[string]any{"text": "new-song-text"})
+
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		_, _, code, err = songServiceClient.GetSyncedLyrics(defaultSong.ID, "")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
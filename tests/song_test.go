@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strings"
@@ -72,6 +74,209 @@ func TestCreate(t *testing.T) {
 	})
 }
 
+func TestCreateManual(t *testing.T) {
+	var (
+		manualReleaseDate = date.NewDate(2021, 2, 3)
+		manualText        = "manual-song-text"
+		manualLink        = "https://example.com/manual-song"
+		invalidLink       = "manual-song-link"
+		emptyText         = " \n "
+	)
+
+	tests := []struct {
+		name               string
+		request            CreateSongRequest
+		queryParams        CreateSongQueryParams
+		expectedStatusCode int
+		expectedSong       Song
+	}{
+		{
+			name: "unknown upstream",
+			request: CreateSongRequest{
+				Group:       nonExistentSong.Group,
+				Song:        nonExistentSong.Name,
+				ReleaseDate: &manualReleaseDate,
+				Text:        &manualText,
+				Link:        &manualLink,
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedSong: Song{
+				Group:       nonExistentSong.Group,
+				Name:        nonExistentSong.Name,
+				ReleaseDate: manualReleaseDate,
+				Text:        manualText,
+				Link:        manualLink,
+			},
+		},
+		{
+			name: "enrich missing",
+			request: CreateSongRequest{
+				Group: defaultSong.Group,
+				Song:  defaultSong.Name,
+				Text:  &manualText,
+			},
+			queryParams:        CreateSongQueryParams{Enrich: "missing"},
+			expectedStatusCode: http.StatusOK,
+			expectedSong: Song{
+				Group:       defaultSong.Group,
+				Name:        defaultSong.Name,
+				ReleaseDate: defaultSong.ReleaseDate,
+				Text:        manualText,
+				Link:        defaultSong.Link,
+			},
+		},
+		{
+			name: "enrich missing unknown upstream",
+			request: CreateSongRequest{
+				Group: nonExistentSong.Group,
+				Song:  nonExistentSong.Name,
+				Text:  &manualText,
+			},
+			queryParams:        CreateSongQueryParams{Enrich: "missing"},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "partial details",
+			request: CreateSongRequest{
+				Group: defaultSong.Group,
+				Song:  defaultSong.Name,
+				Text:  &manualText,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "enrich none without details",
+			request: CreateSongRequest{
+				Group: defaultSong.Group,
+				Song:  defaultSong.Name,
+			},
+			queryParams:        CreateSongQueryParams{Enrich: "none"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid link",
+			request: CreateSongRequest{
+				Group:       nonExistentSong.Group,
+				Song:        nonExistentSong.Name,
+				ReleaseDate: &manualReleaseDate,
+				Text:        &manualText,
+				Link:        &invalidLink,
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "empty text",
+			request: CreateSongRequest{
+				Group:       nonExistentSong.Group,
+				Song:        nonExistentSong.Name,
+				ReleaseDate: &manualReleaseDate,
+				Text:        &emptyText,
+				Link:        &manualLink,
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "language",
+			request: CreateSongRequest{
+				Group:       nonExistentSong.Group,
+				Song:        nonExistentSong.Name,
+				ReleaseDate: &manualReleaseDate,
+				Text:        &manualText,
+				Link:        &manualLink,
+				Language:    "EN_us",
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedSong: Song{
+				Group:       nonExistentSong.Group,
+				Name:        nonExistentSong.Name,
+				ReleaseDate: manualReleaseDate,
+				Text:        manualText,
+				Link:        manualLink,
+				Language:    "en-US",
+			},
+		},
+		{
+			name: "invalid language",
+			request: CreateSongRequest{
+				Group:       nonExistentSong.Group,
+				Song:        nonExistentSong.Name,
+				ReleaseDate: &manualReleaseDate,
+				Text:        &manualText,
+				Link:        &manualLink,
+				Language:    "123",
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "async with details",
+			request: CreateSongRequest{
+				Group: defaultSong.Group,
+				Song:  defaultSong.Name,
+				Text:  &manualText,
+			},
+			queryParams:        CreateSongQueryParams{Async: true},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpCreateTest(); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, code, err := songServiceClient.CreateSong(tt.request, tt.queryParams)
+
+			assert.Equal(t, tt.expectedStatusCode, code)
+
+			if tt.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			tt.expectedSong.ID = resp.Song.ID
+
+			assert.Equal(t, tt.expectedSong, resp.Song)
+		})
+	}
+
+	t.Run("duplicate", func(t *testing.T) {
+		if err := SetUpCreateTest(); err != nil {
+			t.Fatal(err)
+		}
+
+		req := CreateSongRequest{
+			Group:       nonExistentSong.Group,
+			Song:        nonExistentSong.Name,
+			ReleaseDate: &manualReleaseDate,
+			Text:        &manualText,
+			Link:        &manualLink,
+		}
+
+		resp, code, err := songServiceClient.CreateSong(req, nil)
+
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		sameResp, code, err := songServiceClient.CreateSong(req, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, resp.Song, sameResp.Song)
+
+		otherText := "other-song-text"
+		req.Text = &otherText
+
+		_, code, err = songServiceClient.CreateSong(req, nil)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, code)
+	})
+}
+
 func TestUpdateNonExistentSong(t *testing.T) {
 	if err := SetUpEmpty(); err != nil {
 		t.Fatal(err)
@@ -127,7 +332,7 @@ func TestUpdate(t *testing.T) {
 		Name:        "new-song-name",
 		Group:       "new-song-group",
 		Text:        "new-song-text",
-		Link:        "new-song-link",
+		Link:        "https://example.com/new-song-link",
 		ReleaseDate: date.NewDate(2026, 1, 1),
 	}
 
@@ -178,6 +383,92 @@ func TestPartialUpdate(t *testing.T) {
 	assert.Equal(t, expectedSong, resp.Song)
 }
 
+func TestUpdateValidation(t *testing.T) {
+	var (
+		longName   = strings.Repeat("a", 256)
+		futureDate = date.Today().AddDays(30)
+		pastDate   = date.NewDate(2020, 1, 1)
+	)
+
+	tests := []struct {
+		name           string
+		request        UpdateSongRequest
+		partial        bool
+		expectedFields map[string]string
+	}{
+		{
+			name: "update",
+			request: UpdateSongRequest{
+				Name:        longName,
+				Group:       defaultSong.Group,
+				ReleaseDate: &futureDate,
+				Text:        "  ",
+				Link:        "not-a-link",
+			},
+			expectedFields: map[string]string{
+				"song":         "too_long",
+				"release_date": "future_date",
+				"text":         "required",
+				"link":         "invalid_url",
+			},
+		},
+		{
+			name: "partial update",
+			request: UpdateSongRequest{
+				Group:       longName,
+				ReleaseDate: &pastDate,
+				Text:        strings.Repeat("a ", 25001),
+				Link:        "ftp://example.com/song",
+			},
+			partial: true,
+			expectedFields: map[string]string{
+				"group": "too_long",
+				"text":  "too_long",
+				"link":  "invalid_url",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpDefault(); err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				code int
+				err  error
+			)
+
+			if tt.partial {
+				_, code, err = songServiceClient.PartialUpdateSong(defaultSong.ID, tt.request, nil)
+			} else {
+				_, code, err = songServiceClient.UpdateSong(defaultSong.ID, tt.request, nil)
+			}
+
+			require.NotNil(t, err)
+			assert.Equal(t, http.StatusUnprocessableEntity, code)
+
+			var resp Problem
+
+			require.Nil(t, json.Unmarshal([]byte(err.Error()), &resp))
+			assert.Equal(t, "validation_failed", resp.Code)
+
+			fields := make(map[string]string)
+			for _, fieldErr := range resp.Errors {
+				fields[fieldErr.Field] = fieldErr.Code
+			}
+
+			assert.Equal(t, tt.expectedFields, fields)
+
+			song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+			require.Nil(t, err)
+			assert.Equal(t, defaultSong, song)
+		})
+	}
+}
+
 func TestGetNonExistentSong(t *testing.T) {
 	if err := SetUpEmpty(); err != nil {
 		t.Fatal(err)
@@ -217,6 +508,74 @@ func TestGet(t *testing.T) {
 	assert.Equal(t, expectedSong, resp.Song)
 }
 
+func TestConditionalRequests(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	var etag string
+
+	t.Run("get returns etag", func(t *testing.T) {
+		resp, header, code, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, "")
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+
+		etag = header.Get("ETag")
+		assert.NotEmpty(t, etag)
+	})
+
+	t.Run("get not modified", func(t *testing.T) {
+		_, header, code, _ := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, etag)
+
+		assert.Equal(t, http.StatusNotModified, code)
+		assert.Equal(t, etag, header.Get("ETag"))
+	})
+
+	var newETag string
+
+	t.Run("update with matching etag", func(t *testing.T) {
+		request := UpdateSongRequest{Text: "new-text"}
+
+		resp, header, code, err := songServiceClient.PartialUpdateSongIfMatch(defaultSong.ID, request, etag)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "new-text", resp.Song.Text)
+
+		newETag = header.Get("ETag")
+		assert.NotEqual(t, etag, newETag)
+	})
+
+	t.Run("update with stale etag", func(t *testing.T) {
+		request := UpdateSongRequest{Text: "stale-text"}
+
+		_, _, code, err := songServiceClient.PartialUpdateSongIfMatch(defaultSong.ID, request, etag)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+
+		song, err := songServiceDB.GetSongByID(defaultSong.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, "new-text", song.Text)
+	})
+
+	t.Run("get modified", func(t *testing.T) {
+		resp, header, code, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, etag)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, newETag, header.Get("ETag"))
+	})
+}
+
 func TestGetTextPagination(t *testing.T) {
 	song := Song{
 		ID:          uuid.New(),
@@ -224,7 +583,7 @@ func TestGetTextPagination(t *testing.T) {
 		Name:        "song-name",
 		ReleaseDate: date.NewDate(2025, 1, 1),
 		Text:        "verse-1\n\nverse-2\n\nverse-3\n\nverse-4\n\nverse-5\n\nverse-6",
-		Link:        "song-link",
+		Link:        "https://example.com/song-link",
 	}
 
 	var (
@@ -236,18 +595,12 @@ func TestGetTextPagination(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	expectedVersesByLimitAndOffset := func(limit int32, offset int32) string {
-		const sep = "\n\n"
+	const sep = "\n\n"
 
-		verseList := strings.Split(song.Text, sep)
+	verseList := strings.Split(song.Text, sep)
 
-		if offset < 0 {
-			offset = 0
-		} else if offset >= int32(len(verseList)) {
-			return ""
-		}
-
-		if limit <= 0 || offset+limit >= int32(len(verseList)) {
+	expectedVersesByLimitAndOffset := func(limit int32, offset int32) string {
+		if limit == 0 || offset+limit >= int32(len(verseList)) {
 			return strings.Join(verseList[offset:], sep)
 		}
 
@@ -267,23 +620,233 @@ func TestGetTextPagination(t *testing.T) {
 					Offset: offset,
 				}
 
-				var (
-					expectedStatusCode = http.StatusOK
-					expectedText       = expectedVersesByLimitAndOffset(limit, offset)
-				)
-
 				resp, code, err := songServiceClient.GetSong(song.ID, queryParams)
 
+				switch {
+				case limit < 0 || offset < 0:
+					require.Error(t, err)
+					assert.Equal(t, http.StatusBadRequest, code)
+
+					return
+				case offset >= int32(len(verseList)):
+					require.Error(t, err)
+					assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, code)
+
+					var problem Problem
+
+					require.NoError(t, json.Unmarshal([]byte(err.Error()), &problem))
+					assert.Equal(t, "verse_out_of_range", problem.Code)
+
+					return
+				}
+
 				require.NoError(t, err)
 				require.NotNil(t, resp)
 
-				assert.Equal(t, expectedStatusCode, code)
+				expectedText := expectedVersesByLimitAndOffset(limit, offset)
+
+				assert.Equal(t, http.StatusOK, code)
 				assert.Equal(t, expectedText, resp.Song.Text)
+
+				require.NotNil(t, resp.VerseTotal)
+				require.NotNil(t, resp.VerseOffset)
+				require.NotNil(t, resp.VerseLimit)
+				require.NotNil(t, resp.HasMore)
+
+				assert.Equal(t, len(verseList), *resp.VerseTotal)
+				assert.Equal(t, int(offset), *resp.VerseOffset)
+				assert.Equal(t, int(limit), *resp.VerseLimit)
+				assert.Equal(t, int(offset)+len(strings.Split(expectedText, sep)) < len(verseList), *resp.HasMore)
 			})
 		}
 	}
 }
 
+func TestGetVerses(t *testing.T) {
+	song := Song{
+		ID:          uuid.New(),
+		Group:       "song-group",
+		Name:        "song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "[Verse 1]\nline-1\nline-2\n[Chorus]\nchorus-1\n\nverse-2",
+		Link:        "https://example.com/song-link",
+	}
+
+	if err := SetUp([]Song{song}, []Song{song}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("all verses", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(song.ID, SongQueryParams{Verses: true})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, song.Text, resp.Song.Text)
+		assert.Equal(t, []Verse{
+			{Index: 0, Type: "verse", Label: "[Verse 1]", Lines: []string{"line-1", "line-2"}},
+			{Index: 1, Type: "chorus", Label: "[Chorus]", Lines: []string{"chorus-1"}},
+			{Index: 2, Type: "verse", Lines: []string{"verse-2"}},
+		}, resp.Verses)
+		require.NotNil(t, resp.VerseTotal)
+		assert.Equal(t, 3, *resp.VerseTotal)
+	})
+
+	t.Run("page", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(song.ID, SongQueryParams{Verses: true, Limit: 1, Offset: 1})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "[Chorus]\nchorus-1", resp.Song.Text)
+		assert.Equal(t, []Verse{{Index: 1, Type: "chorus", Label: "[Chorus]", Lines: []string{"chorus-1"}}}, resp.Verses)
+		require.NotNil(t, resp.VerseTotal)
+		assert.Equal(t, 3, *resp.VerseTotal)
+		require.NotNil(t, resp.HasMore)
+		assert.True(t, *resp.HasMore)
+	})
+
+	t.Run("without verses", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(song.ID, nil)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Nil(t, resp.Verses)
+		require.NotNil(t, resp.VerseTotal)
+		assert.Equal(t, 3, *resp.VerseTotal)
+	})
+}
+
+func TestGetVerseEndpoints(t *testing.T) {
+	song := Song{
+		ID:          uuid.New(),
+		Group:       "song-group",
+		Name:        "song-name",
+		ReleaseDate: date.NewDate(2025, 1, 1),
+		Text:        "[Verse 1]\nline-1\nline-2\n[Chorus]\nchorus-1\n\nverse-2",
+		Link:        "https://example.com/song-link",
+	}
+
+	if err := SetUp([]Song{song}, []Song{song}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("verses page", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSongVerses(song.ID, SongVersesQueryParams{Limit: 2})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, SongVersesResponse{
+			Verses: []Verse{
+				{Index: 0, Type: "verse", Label: "[Verse 1]", Lines: []string{"line-1", "line-2"}},
+				{Index: 1, Type: "chorus", Label: "[Chorus]", Lines: []string{"chorus-1"}},
+			},
+			VerseTotal:  3,
+			VerseOffset: 0,
+			VerseLimit:  2,
+			HasMore:     true,
+		}, *resp)
+	})
+
+	t.Run("last verses page", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSongVerses(song.ID, SongVersesQueryParams{Limit: 2, Offset: 2})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []Verse{{Index: 2, Type: "verse", Lines: []string{"verse-2"}}}, resp.Verses)
+		assert.False(t, resp.HasMore)
+	})
+
+	t.Run("verses offset out of range", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongVerses(song.ID, SongVersesQueryParams{Offset: 3})
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, code)
+	})
+
+	t.Run("verses negative offset", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongVerses(song.ID, SongVersesQueryParams{Offset: -1})
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("verse", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSongVerse(song.ID, 1, nil)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, SongVerseResponse{
+			Verse:      Verse{Index: 1, Type: "chorus", Label: "[Chorus]", Lines: []string{"chorus-1"}},
+			VerseTotal: 3,
+		}, *resp)
+	})
+
+	t.Run("verse not found", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongVerse(song.ID, 3, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("invalid verse number", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongVerse(song.ID, -1, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("song not found", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSongVerses(uuid.New(), nil)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestTextNormalization(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		releaseDate = date.NewDate(2021, 2, 3)
+		text        = "\r\nline-1  \r\nline-2\r\n\r\n \r\n\r\nline-3\r\n"
+		link        = "https://example.com/song-link"
+	)
+
+	request := CreateSongRequest{
+		Group:       "song-group",
+		Song:        "song-name",
+		ReleaseDate: &releaseDate,
+		Text:        &text,
+		Link:        &link,
+	}
+
+	resp, code, err := songServiceClient.CreateSong(request, nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "line-1\nline-2\n\nline-3", resp.Song.Text)
+
+	song, err := songServiceDB.GetSongByID(resp.Song.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "line-1\nline-2\n\nline-3", song.Text)
+}
+
 func TestDeleteNonExistentSong(t *testing.T) {
 	if err := SetUpEmpty(); err != nil {
 		t.Fatal(err)
@@ -332,6 +895,74 @@ func TestDelete(t *testing.T) {
 	})
 }
 
+func TestRestoreNonExistentSong(t *testing.T) {
+	if err := SetUpEmpty(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, code, err := songServiceClient.RestoreSong(nonExistentSong.ID)
+
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestDeleteAndRestore(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("deleted song in trash", func(t *testing.T) {
+		deleteResp, code, err := songServiceClient.DeleteSong(defaultSong.ID, nil)
+
+		require.Nil(t, err)
+		require.NotNil(t, deleteResp)
+		assert.Equal(t, http.StatusOK, code)
+
+		resp, code, err := songServiceClient.ListDeletedSong(nil)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		require.Equal(t, 1, len(resp.SongList))
+		assert.Equal(t, defaultSong, resp.SongList[0].Song)
+		assert.Equal(t, deleteResp.DeletedTime, resp.SongList[0].DeletedTime)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		resp, code, err := songServiceClient.RestoreSong(defaultSong.ID)
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, defaultSong, resp.Song)
+
+		trashResp, _, err := songServiceClient.ListDeletedSong(nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(trashResp.SongList))
+	})
+
+	t.Run("restore conflict", func(t *testing.T) {
+		_, code, err := songServiceClient.DeleteSong(defaultSong.ID, nil)
+
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		duplicate := defaultSong
+		duplicate.ID = uuid.New()
+
+		_, err = songServiceDB.CreateSong(duplicate)
+		require.Nil(t, err)
+
+		_, code, err = songServiceClient.RestoreSong(defaultSong.ID)
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusConflict, code)
+	})
+}
+
 func TestListEmpty(t *testing.T) {
 	if err := SetUpEmpty(); err != nil {
 		t.Fatal(err)
@@ -362,7 +993,7 @@ func TestList(t *testing.T) {
 				songName        = fmt.Sprintf("name-%d", j)
 				songReleaseDate = date.NewDate(2024, 1, 1)
 				songText        = "song-text"
-				songLink        = "song-link"
+				songLink        = "https://example.com/song-link"
 			)
 
 			song := Song{
@@ -481,3 +1112,464 @@ func TestList(t *testing.T) {
 		assert.Equal(t, len(songList)-int(queryParams.Offset), len(resp.SongList))
 	})
 }
+
+func TestListSearch(t *testing.T) {
+	songList := []Song{
+		{
+			ID:          uuid.New(),
+			Group:       "Queen",
+			Name:        "Bohemian Rhapsody",
+			ReleaseDate: date.NewDate(1975, 10, 31),
+			Text:        "Is this the real life?\nIs this just fantasy?\n\nMama, just killed a man",
+			Link:        "https://example.com/song-link-1",
+		},
+		{
+			ID:          uuid.New(),
+			Group:       "Muse",
+			Name:        "Starlight",
+			ReleaseDate: date.NewDate(2006, 9, 4),
+			Text:        "Far away\nThe ship is taking me far away\n\nMy life, you electrify my life",
+			Link:        "https://example.com/song-link-2",
+		},
+	}
+
+	if err := SetUp(nil, songList); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		expectedStatusCode = http.StatusOK
+	)
+
+	t.Run("search by text", func(t *testing.T) {
+		resp, code, err := songServiceClient.SearchSong(SongListQueryParams{Query: "killed"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, 1, len(resp.SongList))
+		assert.Equal(t, songList[0].ID, resp.SongList[0].ID)
+		require.NotNil(t, resp.SongList[0].Rank)
+		require.NotNil(t, resp.SongList[0].Headline)
+		assert.Contains(t, *resp.SongList[0].Headline, "<mark>killed</mark>")
+	})
+
+	t.Run("search by group and stemmed word", func(t *testing.T) {
+		resp, code, err := songServiceClient.SearchSong(SongListQueryParams{Query: "queen fantasies"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, 1, len(resp.SongList))
+		assert.Equal(t, songList[0].ID, resp.SongList[0].ID)
+	})
+
+	t.Run("search by phrase", func(t *testing.T) {
+		resp, code, err := songServiceClient.SearchSong(SongListQueryParams{Query: `"taking me far away"`})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, 1, len(resp.SongList))
+		assert.Equal(t, songList[1].ID, resp.SongList[0].ID)
+	})
+
+	t.Run("search by renamed group", func(t *testing.T) {
+		groupID, err := songServiceDB.CreateGroup(songList[1].Group)
+		require.Nil(t, err)
+
+		_, code, err := songServiceClient.RenameGroup(groupID, RenameGroupRequest{Name: "Placebo"})
+
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		resp, code, err := songServiceClient.SearchSong(SongListQueryParams{Query: "placebo starlight"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, 1, len(resp.SongList))
+		assert.Equal(t, songList[1].ID, resp.SongList[0].ID)
+	})
+
+	t.Run("search ranks matches", func(t *testing.T) {
+		resp, code, err := songServiceClient.SearchSong(SongListQueryParams{Query: "life"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, 2, len(resp.SongList))
+		assert.GreaterOrEqual(t, *resp.SongList[0].Rank, *resp.SongList[1].Rank)
+	})
+}
+
+func TestListMatchModes(t *testing.T) {
+	songList := []Song{
+		{
+			ID:          uuid.New(),
+			Group:       "Queen",
+			Name:        "Bohemian Rhapsody",
+			ReleaseDate: date.NewDate(1975, 10, 31),
+			Text:        "song-text",
+			Link:        "https://example.com/queen/bohemian-rhapsody",
+		},
+		{
+			ID:          uuid.New(),
+			Group:       "Queens of the Stone Age",
+			Name:        "No One Knows",
+			ReleaseDate: date.NewDate(2002, 11, 25),
+			Text:        "song-text",
+			Link:        "https://example.com/qotsa/no-one-knows",
+		},
+	}
+
+	if err := SetUp(nil, songList); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name          string
+		queryParams   SongListQueryParams
+		expectedCount int
+	}{
+		{
+			name:          "exact is case sensitive",
+			queryParams:   SongListQueryParams{Group: []string{"queen"}},
+			expectedCount: 0,
+		},
+		{
+			name:          "insensitive",
+			queryParams:   SongListQueryParams{Group: []string{"queen"}, GroupMode: "insensitive"},
+			expectedCount: 1,
+		},
+		{
+			name:          "prefix",
+			queryParams:   SongListQueryParams{Group: []string{"queen"}, GroupMode: "prefix"},
+			expectedCount: 2,
+		},
+		{
+			name:          "contains",
+			queryParams:   SongListQueryParams{Name: []string{"one"}, NameMode: "contains"},
+			expectedCount: 1,
+		},
+		{
+			name:          "contains escapes wildcards",
+			queryParams:   SongListQueryParams{Link: []string{"%"}, LinkMode: "contains"},
+			expectedCount: 0,
+		},
+		{
+			name:          "fuzzy",
+			queryParams:   SongListQueryParams{Name: []string{"Bohemain Rapsody"}, NameMode: "fuzzy"},
+			expectedCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, code, err := songServiceClient.SearchSong(tc.queryParams)
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, tc.expectedCount, len(resp.SongList))
+
+			for _, song := range resp.SongList {
+				if tc.queryParams.NameMode == "fuzzy" {
+					require.NotNil(t, song.Similarity)
+					assert.Greater(t, *song.Similarity, float32(0))
+				} else {
+					assert.Nil(t, song.Similarity)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid mode", func(t *testing.T) {
+		_, code, err := songServiceClient.ListSong(SongListQueryParams{Group: []string{"queen"}, GroupMode: "regex"})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestListSort(t *testing.T) {
+	var songList []Song
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			song := Song{
+				ID:          uuid.New(),
+				Name:        fmt.Sprintf("name-%d", j),
+				Group:       fmt.Sprintf("group-%d", i),
+				ReleaseDate: date.NewDate(2020+j, 1, 1),
+				Text:        "song-text",
+				Link:        "https://example.com/song-link",
+			}
+
+			songList = append(songList, song)
+		}
+	}
+
+	if err := SetUp(nil, songList); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		expectedStatusCode = http.StatusOK
+	)
+
+	t.Run("sort by multiple keys", func(t *testing.T) {
+		resp, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: "-release_date,group"})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, expectedStatusCode, code)
+		require.Equal(t, len(songList), len(resp.SongList))
+
+		assert.True(t, sort.SliceIsSorted(resp.SongList, func(i, j int) bool {
+			a, b := resp.SongList[i], resp.SongList[j]
+			if a.ReleaseDate != b.ReleaseDate {
+				return b.ReleaseDate.Before(a.ReleaseDate)
+			}
+
+			return a.Group < b.Group
+		}))
+	})
+
+	t.Run("pages do not overlap", func(t *testing.T) {
+		seen := make(map[uuid.UUID]bool)
+
+		for offset := int32(0); offset < int32(len(songList)); offset += 2 {
+			resp, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: "song", Limit: 2, Offset: offset})
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, expectedStatusCode, code)
+
+			for _, song := range resp.SongList {
+				assert.False(t, seen[song.ID])
+				seen[song.ID] = true
+			}
+		}
+
+		assert.Equal(t, len(songList), len(seen))
+	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		_, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: "text"})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("relevance without query", func(t *testing.T) {
+		_, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: "-relevance"})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestListCursor(t *testing.T) {
+	var songList []Song
+
+	for i := 0; i < 7; i++ {
+		song := Song{
+			ID:          uuid.New(),
+			Name:        fmt.Sprintf("name-%d", i),
+			Group:       fmt.Sprintf("group-%d", i%2),
+			ReleaseDate: date.NewDate(2020+i%3, 1, 1),
+			Text:        "song-text",
+			Link:        "https://example.com/song-link",
+		}
+
+		songList = append(songList, song)
+	}
+
+	if err := SetUp(nil, songList); err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		sortOrder = "-release_date,group"
+		pageSize  = 3
+	)
+
+	var (
+		forwardPages [][]Song
+		lastCursor   *string
+	)
+
+	t.Run("forward", func(t *testing.T) {
+		queryParams := SongListQueryParams{Sort: sortOrder, Limit: pageSize}
+
+		seen := make(map[uuid.UUID]bool)
+
+		for {
+			resp, code, err := songServiceClient.ListSong(queryParams)
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, http.StatusOK, code)
+
+			for _, song := range resp.SongList {
+				assert.False(t, seen[song.ID])
+				seen[song.ID] = true
+			}
+
+			forwardPages = append(forwardPages, resp.SongList)
+
+			if resp.NextCursor == nil {
+				lastCursor = resp.PrevCursor
+				break
+			}
+
+			queryParams.Cursor = *resp.NextCursor
+		}
+
+		assert.Equal(t, len(songList), len(seen))
+		assert.Equal(t, 3, len(forwardPages))
+	})
+
+	t.Run("backward", func(t *testing.T) {
+		require.NotNil(t, lastCursor)
+
+		resp, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: sortOrder, Limit: pageSize, Cursor: *lastCursor})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, forwardPages[1], resp.SongList)
+		assert.NotNil(t, resp.PrevCursor)
+		assert.NotNil(t, resp.NextCursor)
+	})
+
+	t.Run("forged cursor", func(t *testing.T) {
+		_, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: sortOrder, Limit: pageSize, Cursor: "e30.AAAA"})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("cursor with different sort", func(t *testing.T) {
+		resp, _, err := songServiceClient.ListSong(SongListQueryParams{Sort: sortOrder, Limit: pageSize})
+
+		require.Nil(t, err)
+		require.NotNil(t, resp.NextCursor)
+
+		_, code, err := songServiceClient.ListSong(SongListQueryParams{Sort: "song", Limit: pageSize, Cursor: *resp.NextCursor})
+
+		require.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestListTotal(t *testing.T) {
+	var songList []Song
+
+	for i := 0; i < 5; i++ {
+		song := Song{
+			ID:          uuid.New(),
+			Name:        fmt.Sprintf("name-%d", i),
+			Group:       fmt.Sprintf("group-%d", i%2),
+			ReleaseDate: date.NewDate(2020, 1, 1),
+			Text:        "song-text",
+			Link:        "https://example.com/song-link",
+		}
+
+		songList = append(songList, song)
+	}
+
+	if err := SetUp(nil, songList); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		queryParams SongListQueryParams
+		wantTotal   int64
+		wantLen     int
+		wantHasMore bool
+	}{
+		{
+			name:        "without total",
+			queryParams: SongListQueryParams{Limit: 2},
+			wantLen:     2,
+			wantHasMore: true,
+		},
+		{
+			name:        "with total",
+			queryParams: SongListQueryParams{Limit: 2, IncludeTotal: true},
+			wantTotal:   5,
+			wantLen:     2,
+			wantHasMore: true,
+		},
+		{
+			name:        "last page",
+			queryParams: SongListQueryParams{Limit: 2, Offset: 4, IncludeTotal: true},
+			wantTotal:   5,
+			wantLen:     1,
+			wantHasMore: false,
+		},
+		{
+			name:        "filtered",
+			queryParams: SongListQueryParams{Group: []string{"group-0"}, Limit: 10, IncludeTotal: true},
+			wantTotal:   3,
+			wantLen:     3,
+			wantHasMore: false,
+		},
+		{
+			name:        "empty page",
+			queryParams: SongListQueryParams{Limit: 2, Offset: 10, IncludeTotal: true},
+			wantTotal:   5,
+			wantLen:     0,
+			wantHasMore: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, code, err := songServiceClient.ListSong(tc.queryParams)
+
+			require.Nil(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, http.StatusOK, code)
+			if tc.queryParams.IncludeTotal {
+				require.NotNil(t, resp.Total)
+				assert.Equal(t, tc.wantTotal, *resp.Total)
+			} else {
+				assert.Nil(t, resp.Total)
+			}
+
+			assert.Equal(t, tc.wantLen, len(resp.SongList))
+			assert.Equal(t, tc.wantHasMore, resp.HasMore)
+			assert.Equal(t, tc.queryParams.Limit, resp.Limit)
+			assert.Equal(t, tc.queryParams.Offset, resp.Offset)
+		})
+	}
+}
+
+func TestListInvalidLimit(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, limit := range []int32{-1, 1001, math.MaxInt32} {
+		t.Run(fmt.Sprint(limit), func(t *testing.T) {
+			_, code, err := songServiceClient.ListSong(SongListQueryParams{Limit: limit})
+
+			require.NotNil(t, err)
+			assert.Equal(t, http.StatusBadRequest, code)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const translationText = "Erste Strophe\nzweite Zeile\n\nRefrain\n\nZweite Strophe\n\nRefrain"
+
+func TestSongTranslations(t *testing.T) {
+	if err := SetUpDefault(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, header, _, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, "")
+
+	require.NoError(t, err)
+
+	etag := header.Get("ETag")
+
+	text := translationText
+
+	resp, header, code, err := songServiceClient.UpdateSongTranslation(defaultSong.ID, "DE", UpdateSongTranslationRequest{Text: &text}, etag)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "de", resp.Translation.Language)
+	assert.Equal(t, translationText, resp.Translation.Text)
+	assert.NotEqual(t, etag, header.Get("ETag"))
+
+	t.Run("stale if-match", func(t *testing.T) {
+		_, _, code, err := songServiceClient.UpdateSongTranslation(defaultSong.ID, "de", UpdateSongTranslationRequest{Text: &text}, etag)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, code)
+	})
+
+	t.Run("list and get", func(t *testing.T) {
+		list, code, err := songServiceClient.ListSongTranslations(defaultSong.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, list.Translations, 1)
+		assert.Equal(t, "de", list.Translations[0].Language)
+
+		translation, code, err := songServiceClient.GetSongTranslation(defaultSong.ID, "de")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, translationText, translation.Translation.Text)
+	})
+
+	tests := []struct {
+		name             string
+		lang             string
+		acceptLanguage   string
+		expectedText     string
+		expectedLanguage string
+	}{
+		{
+			name:             "lang parameter",
+			lang:             "de",
+			expectedText:     translationText,
+			expectedLanguage: "de",
+		},
+		{
+			name:             "lang parameter with region",
+			lang:             "de-CH",
+			expectedText:     translationText,
+			expectedLanguage: "de",
+		},
+		{
+			name:             "accept-language",
+			acceptLanguage:   "fr;q=0.9, de-AT;q=0.8",
+			expectedText:     translationText,
+			expectedLanguage: "de",
+		},
+		{
+			name:           "lang parameter over accept-language",
+			lang:           "fr",
+			acceptLanguage: "de",
+			expectedText:   defaultSong.Text,
+		},
+		{
+			name:           "no matching translation",
+			acceptLanguage: "fr",
+			expectedText:   defaultSong.Text,
+		},
+		{
+			name:         "no preference",
+			expectedText: defaultSong.Text,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, header, code, err := songServiceClient.GetSongAcceptLanguage(defaultSong.ID, SongQueryParams{Lang: tt.lang}, tt.acceptLanguage)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, tt.expectedText, resp.Song.Text)
+			assert.Equal(t, tt.expectedLanguage, resp.Language)
+			assert.Equal(t, tt.expectedLanguage, header.Get("Content-Language"))
+			assert.Contains(t, header.Values("Vary"), "Accept-Language")
+		})
+	}
+
+	t.Run("verse pagination", func(t *testing.T) {
+		resp, code, err := songServiceClient.GetSong(defaultSong.ID, SongQueryParams{Lang: "de", Offset: 1, Limit: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Refrain\n\nZweite Strophe", resp.Song.Text)
+		require.NotNil(t, resp.VerseTotal)
+		assert.Equal(t, 4, *resp.VerseTotal)
+
+		verses, code, err := songServiceClient.GetSongVerses(defaultSong.ID, SongVersesQueryParams{Lang: "de", Offset: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "de", verses.Language)
+		require.Len(t, verses.Verses, 1)
+		assert.Equal(t, []string{"Refrain"}, verses.Verses[0].Lines)
+		assert.Equal(t, repeatOf(1), verses.Verses[0].RepeatOf)
+
+		verse, code, err := songServiceClient.GetSongVerse(defaultSong.ID, 2, SongVerseQueryParams{Lang: "de"})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "de", verse.Language)
+		assert.Equal(t, 4, verse.VerseTotal)
+		assert.Equal(t, []string{"Zweite Strophe"}, verse.Verse.Lines)
+
+		_, code, err = songServiceClient.GetSongVerse(defaultSong.ID, 2, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("list filter", func(t *testing.T) {
+		resp, code, err := songServiceClient.ListSong(SongListQueryParams{Lang: []string{"DE"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, resp.SongList, 1)
+		assert.Equal(t, defaultSong.ID, resp.SongList[0].ID)
+
+		resp, code, err = songServiceClient.ListSong(SongListQueryParams{Lang: []string{"fr"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, resp.SongList)
+	})
+
+	t.Run("etag per language", func(t *testing.T) {
+		_, header, code, err := songServiceClient.GetSongAcceptLanguage(defaultSong.ID, nil, "de")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		translationETag := header.Get("ETag")
+
+		resp, header, code, err := songServiceClient.GetSongIfNoneMatch(defaultSong.ID, translationETag)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, defaultSong.Text, resp.Song.Text)
+		assert.NotEqual(t, translationETag, header.Get("ETag"))
+
+		_, _, code, err = songServiceClient.UpdateSongTranslation(defaultSong.ID, "de", UpdateSongTranslationRequest{Text: &text}, translationETag)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp, code, err := songServiceClient.DeleteSongTranslation(defaultSong.ID, "de")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "de", resp.Translation.Language)
+
+		_, code, err = songServiceClient.GetSongTranslation(defaultSong.ID, "de")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		song, _, code, err := songServiceClient.GetSongAcceptLanguage(defaultSong.ID, SongQueryParams{Lang: "de"}, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, defaultSong.Text, song.Song.Text)
+		assert.Empty(t, song.Language)
+	})
+}
+
+func TestSongOriginalLanguage(t *testing.T) {
+	song := defaultSong
+	song.Language = "en"
+
+	if err := SetUp(nil, []Song{song}); err != nil {
+		t.Fatal(err)
+	}
+
+	text := translationText
+
+	_, _, code, err := songServiceClient.UpdateSongTranslation(song.ID, "de", UpdateSongTranslationRequest{Text: &text}, "")
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	tests := []struct {
+		name             string
+		lang             string
+		acceptLanguage   string
+		expectedText     string
+		expectedLanguage string
+	}{
+		{
+			name:           "original preferred",
+			acceptLanguage: "en-US,en;q=0.9,de;q=0.5",
+			expectedText:   song.Text,
+		},
+		{
+			name:         "original language parameter",
+			lang:         "en-GB",
+			expectedText: song.Text,
+		},
+		{
+			name:             "translation preferred",
+			acceptLanguage:   "de,en;q=0.5",
+			expectedText:     translationText,
+			expectedLanguage: "de",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, _, code, err := songServiceClient.GetSongAcceptLanguage(song.ID, SongQueryParams{Lang: tt.lang}, tt.acceptLanguage)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, tt.expectedText, resp.Song.Text)
+			assert.Equal(t, "en", resp.Song.Language)
+			assert.Equal(t, tt.expectedLanguage, resp.Language)
+		})
+	}
+
+	t.Run("list filter matches regional variants", func(t *testing.T) {
+		resp, code, err := songServiceClient.ListSong(SongListQueryParams{Lang: []string{"de-CH"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, resp.SongList, 1)
+		assert.Equal(t, song.ID, resp.SongList[0].ID)
+	})
+}
+
+func TestSongTranslationErrors(t *testing.T) {
+	text := translationText
+	emptyText := " \n "
+
+	tests := []struct {
+		name           string
+		id             uuid.UUID
+		lang           string
+		text           *string
+		expectedStatus int
+		expectedErrors []FieldError
+	}{
+		{
+			name:           "invalid language",
+			id:             defaultSong.ID,
+			lang:           "123",
+			text:           &text,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "empty text",
+			id:             defaultSong.ID,
+			lang:           "de",
+			text:           &emptyText,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedErrors: []FieldError{
+				{Field: "text", Code: "required", Message: "must not be empty"},
+			},
+		},
+		{
+			name:           "missing text",
+			id:             defaultSong.ID,
+			lang:           "de",
+			expectedStatus: http.StatusBadRequest,
+			expectedErrors: []FieldError{
+				{Field: "text", Code: "required", Message: "is required"},
+			},
+		},
+		{
+			name:           "unknown song",
+			id:             uuid.New(),
+			lang:           "de",
+			text:           &text,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUpDefault(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, code, err := songServiceClient.UpdateSongTranslation(tt.id, tt.lang, UpdateSongTranslationRequest{Text: tt.text}, "")
+
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedStatus, code)
+
+			var problem Problem
+
+			require.NoError(t, json.Unmarshal([]byte(err.Error()), &problem))
+			assert.Equal(t, tt.expectedErrors, problem.Errors)
+
+			list, code, err := songServiceClient.ListSongTranslations(defaultSong.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Empty(t, list.Translations)
+		})
+	}
+
+	t.Run("invalid lang parameter", func(t *testing.T) {
+		_, code, err := songServiceClient.GetSong(defaultSong.ID, SongQueryParams{Lang: "123"})
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("missing translation", func(t *testing.T) {
+		_, code, err := songServiceClient.DeleteSongTranslation(defaultSong.ID, "de")
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}